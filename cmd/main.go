@@ -9,12 +9,21 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
+	_ "time/tzdata" // the runtime image has no zoneinfo, user time zones are loaded from the embedded copy
 
 	"github.com/joho/godotenv"
 
 	adapterdb "github.com/ARUMANDESU/go-revise/internal/adapters/db"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/email"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/webhook"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/webpage"
 	"github.com/ARUMANDESU/go-revise/internal/application"
+	apitokenapp "github.com/ARUMANDESU/go-revise/internal/application/apitoken"
+	apitokencmd "github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	apitokenquery "github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+	intervalprofileapp "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile"
+	intervalprofilecmd "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/command"
+	intervalprofilequery "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/query"
 	"github.com/ARUMANDESU/go-revise/internal/application/notification"
 	reviseitemapp "github.com/ARUMANDESU/go-revise/internal/application/reviseitem"
 	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
@@ -23,7 +32,13 @@ import (
 	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
 	userquery "github.com/ARUMANDESU/go-revise/internal/application/user/query"
 	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/outbox"
 	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
 	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
 	httport "github.com/ARUMANDESU/go-revise/internal/ports/http"
 	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot"
@@ -74,8 +89,32 @@ func main() {
 
 	userRepo := repository.NewSQLiteRepo(db)
 	reviseitemRepo := reviseitem.NewSQLiteRepo(db)
+	intervalProfileRepo := intervalprofile.NewSQLiteRepo(db)
+	outboxRepo := outbox.NewSQLiteRepo(db)
+	conversationRepo := conversation.NewSQLiteRepo(db)
+	apiTokenRepo := apitoken.NewSQLiteRepo(db)
+	intervalsResolver := intervalprofile.NewResolver(&intervalProfileRepo, &userRepo)
+
+	schedulingAlgorithm, err := scheduler.ParseAlgorithm(cfg.Scheduler.Algorithm)
+	if err != nil {
+		log.Error("failed to parse scheduling algorithm", logutil.Err(err))
+		panic(err)
+	}
+
+	var dueCounts reviseitemcmd.DueCountsProvider
+	if cfg.Scheduler.LoadBalance {
+		dueCounts = &reviseitemRepo
+	}
 
 	var tgBotPort tgbot.Port
+	notifiers := notification.Notifiers{
+		domainUser.ChannelTelegram: &tgBotPort,
+		domainUser.ChannelWebhook:  webhook.NewNotifier(cfg.Notification.Webhook),
+	}
+	if cfg.Notification.SMTP.Host != "" {
+		notifiers[domainUser.ChannelEmail] = email.NewNotifier(cfg.Notification.SMTP)
+	}
+
 	app := application.Application{
 		User: userapp.Application{
 			Commands: userapp.Commands{
@@ -88,32 +127,92 @@ func main() {
 		},
 		ReviseItem: reviseitemapp.Application{
 			Query: reviseitemapp.Query{
-				GetReviseItem:       reviseitemquery.NewGetReviseItemHandler(&reviseitemRepo),
-				ListUserReviseItems: reviseitemquery.NewListUserReviseItemsHandler(&reviseitemRepo),
+				GetReviseItem:         reviseitemquery.NewGetReviseItemHandler(&reviseitemRepo),
+				ListUserReviseItems:   reviseitemquery.NewListUserReviseItemsHandler(&reviseitemRepo),
+				ListDueReviseItems:    reviseitemquery.NewListDueReviseItemsHandler(&reviseitemRepo, &userRepo, &userRepo),
+				ListUserTags:          reviseitemquery.NewListUserTagsHandler(&reviseitemRepo),
+				SearchUserReviseItems: reviseitemquery.NewSearchUserReviseItemsHandler(&reviseitemRepo),
 			},
 			Command: reviseitemapp.Command{
-				NewReviseItem:     reviseitemcmd.NewNewReviseItemHandler(&reviseitemRepo),
+				NewReviseItem: reviseitemcmd.NewNewReviseItemHandler(
+					&reviseitemRepo,
+					intervalsResolver,
+					&intervalProfileRepo,
+					webpage.NewTitleFetcher(cfg.Webpage),
+				),
 				DeleteReviseItem:  reviseitemcmd.NewDeleteReviseItemHandler(&reviseitemRepo),
+				RestoreReviseItem: reviseitemcmd.NewRestoreReviseItemHandler(&reviseitemRepo),
 				ChangeDescription: reviseitemcmd.NewChangeDescriptionHandler(&reviseitemRepo),
 				ChangeName:        reviseitemcmd.NewChangeNameHandler(&reviseitemRepo),
 				AddTags:           reviseitemcmd.NewAddTagsHandler(&reviseitemRepo),
 				RemoveTags:        reviseitemcmd.NewRemoveTagsHandler(&reviseitemRepo),
-				Review:            reviseitemcmd.NewReviewHandler(&reviseitemRepo),
+				Review: reviseitemcmd.NewReviewHandler(
+					&reviseitemRepo,
+					intervalsResolver,
+					&userRepo,
+					schedulingAlgorithm,
+					dueCounts,
+				),
+				Postpone:      reviseitemcmd.NewPostponeHandler(&reviseitemRepo),
+				ResetProgress: reviseitemcmd.NewResetProgressHandler(&reviseitemRepo, intervalsResolver),
+				SetIntervalProfile: reviseitemcmd.NewSetIntervalProfileHandler(
+					&reviseitemRepo,
+					&intervalProfileRepo,
+				),
+				ChangeReviseItem: reviseitemcmd.NewChangeReviseItemHandler(
+					&reviseitemRepo,
+					&intervalProfileRepo,
+				),
+			},
+		},
+		IntervalProfile: intervalprofileapp.Application{
+			Commands: intervalprofileapp.Commands{
+				CreateProfile: intervalprofilecmd.NewCreateProfileHandler(&intervalProfileRepo),
+				ChangeProfile: intervalprofilecmd.NewChangeProfileHandler(&intervalProfileRepo),
+				DeleteProfile: intervalprofilecmd.NewDeleteProfileHandler(
+					&intervalProfileRepo,
+					&intervalProfileRepo,
+				),
+				AttachToTag: intervalprofilecmd.NewAttachToTagHandler(
+					&intervalProfileRepo,
+					&intervalProfileRepo,
+				),
+				DetachFromTag: intervalprofilecmd.NewDetachFromTagHandler(&intervalProfileRepo),
+			},
+			Queries: intervalprofileapp.Queries{
+				ListUserIntervalProfiles: intervalprofilequery.NewListUserIntervalProfilesHandler(
+					&intervalProfileRepo,
+				),
 			},
 		},
 		Notification: notification.Application{
 			UserProvider:       &userRepo,
 			ReviseItemProvider: &reviseitemRepo,
-			Notifier:           &tgBotPort,
+			Outbox:             &outboxRepo,
+			Notifiers:          notifiers,
+			CatchUp:            cfg.Notification.CatchUp,
+		},
+		APIToken: apitokenapp.Application{
+			Commands: apitokenapp.Commands{
+				CreateToken:  apitokencmd.NewCreateTokenHandler(&apiTokenRepo),
+				RevokeToken:  apitokencmd.NewRevokeTokenHandler(&apiTokenRepo),
+				Authenticate: apitokencmd.NewAuthenticateHandler(&apiTokenRepo),
+			},
+			Queries: apitokenapp.Queries{
+				ListUserTokens: apitokenquery.NewListUserTokensHandler(&apiTokenRepo),
+			},
 		},
 	}
 
 	httpPort := httport.NewPort(cfg, app)
-	tgBotPort, err = tgbot.NewPort(cfg.Telegram, app)
+	tgBotPort, err = tgbot.NewPort(cfg.Telegram, app, &conversationRepo)
 	if err != nil {
 		log.Error("failed to create new telegram bot port", logutil.Err(err))
 	}
 
+	notificationScheduler := notification.NewScheduler(app.Notification, cfg.Notification.Interval)
+	schedulerStopped := make(chan struct{})
+
 	gracefulShutdown := make(chan struct{})
 	go func() {
 		stop := make(chan os.Signal, 1)
@@ -133,6 +232,9 @@ func main() {
 			log.Error("failed to stop telegram bot port", logutil.Err(err))
 		}
 
+		// let the in-flight notifications finish
+		<-schedulerStopped
+
 		close(gracefulShutdown)
 	}()
 
@@ -151,19 +253,8 @@ func main() {
 	}()
 
 	go func() {
-		ticker := time.NewTicker(1 * time.Minute)
-		for {
-			select {
-			case <-ticker.C:
-				log.Debug("notifying users")
-				err := app.Notification.NotifyUsers(context.Background())
-				if err != nil {
-					log.Error("failed to notify users", logutil.Err(err))
-				}
-			case <-ctx.Done():
-				return
-			}
-		}
+		defer close(schedulerStopped)
+		notificationScheduler.Run(ctx)
 	}()
 
 	<-gracefulShutdown
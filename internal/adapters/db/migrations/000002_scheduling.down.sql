@@ -0,0 +1,7 @@
+ALTER TABLE revisions DROP COLUMN grade;
+
+ALTER TABLE revise_items DROP COLUMN interval_seconds;
+ALTER TABLE revise_items DROP COLUMN repetitions;
+ALTER TABLE revise_items DROP COLUMN difficulty;
+ALTER TABLE revise_items DROP COLUMN stability;
+ALTER TABLE revise_items DROP COLUMN ease;
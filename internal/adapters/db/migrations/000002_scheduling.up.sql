@@ -0,0 +1,7 @@
+ALTER TABLE revise_items ADD COLUMN ease REAL NOT NULL DEFAULT 0; -- SM-2 easiness factor
+ALTER TABLE revise_items ADD COLUMN stability REAL NOT NULL DEFAULT 0; -- FSRS stability in days
+ALTER TABLE revise_items ADD COLUMN difficulty REAL NOT NULL DEFAULT 0; -- FSRS difficulty 1-10
+ALTER TABLE revise_items ADD COLUMN repetitions INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE revise_items ADD COLUMN interval_seconds INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE revisions ADD COLUMN grade INTEGER NOT NULL DEFAULT 0; -- 1 again, 2 hard, 3 good, 4 easy
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN review_intervals TEXT; -- space separated intervals, e.g. "1d 3d 1w", NULL means default
@@ -0,0 +1,4 @@
+ALTER TABLE revise_items DROP COLUMN interval_profile_id;
+
+DROP TABLE IF EXISTS interval_profile_tags;
+DROP TABLE IF EXISTS interval_profiles;
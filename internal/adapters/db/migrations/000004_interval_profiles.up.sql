@@ -0,0 +1,21 @@
+CREATE TABLE interval_profiles (
+    id TEXT PRIMARY KEY, -- UUID
+    user_id TEXT NOT NULL, -- UUID
+    name TEXT NOT NULL,
+    intervals TEXT NOT NULL, -- space separated intervals, e.g. "1d 3d 1w"
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (user_id, name),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE TABLE interval_profile_tags (
+    user_id TEXT NOT NULL, -- UUID
+    tag TEXT NOT NULL,
+    profile_id TEXT NOT NULL, -- UUID
+    PRIMARY KEY (user_id, tag),
+    FOREIGN KEY (user_id) REFERENCES users(id),
+    FOREIGN KEY (profile_id) REFERENCES interval_profiles(id)
+);
+
+ALTER TABLE revise_items ADD COLUMN interval_profile_id TEXT; -- UUID, NULL means no profile
@@ -0,0 +1,3 @@
+ALTER TABLE revisions DROP COLUMN confidence;
+ALTER TABLE revisions DROP COLUMN duration_seconds;
+ALTER TABLE revisions DROP COLUMN notes;
@@ -0,0 +1,3 @@
+ALTER TABLE revisions ADD COLUMN notes TEXT; -- free-text notes taken during the revision
+ALTER TABLE revisions ADD COLUMN duration_seconds INTEGER NOT NULL DEFAULT 0; -- time spent on the revision, 0 if not tracked
+ALTER TABLE revisions ADD COLUMN confidence INTEGER NOT NULL DEFAULT 0; -- self-rated confidence 1-5, 0 if not rated
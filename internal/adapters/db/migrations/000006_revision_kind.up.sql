@@ -0,0 +1 @@
+ALTER TABLE revisions ADD COLUMN kind TEXT NOT NULL DEFAULT 'review'; -- review, relapse (moved back on the ladder) or reset (progress restarted)
@@ -0,0 +1,2 @@
+ALTER TABLE users DROP COLUMN daily_new_item_limit;
+ALTER TABLE users DROP COLUMN daily_review_limit;
@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN daily_review_limit INTEGER NOT NULL DEFAULT 0; -- max reviews per day, 0 means no limit
+ALTER TABLE users ADD COLUMN daily_new_item_limit INTEGER NOT NULL DEFAULT 0; -- max never reviewed items per day, 0 means no limit
@@ -0,0 +1,10 @@
+ALTER TABLE users DROP COLUMN quiet_hours_end;
+ALTER TABLE users DROP COLUMN quiet_hours_start;
+ALTER TABLE users ADD COLUMN reminder_time TEXT NOT NULL DEFAULT '7:0';
+
+UPDATE users
+    SET reminder_time = COALESCE(
+        (SELECT MIN(reminder_time) FROM user_reminder_slots WHERE user_id = users.id),
+        reminder_time);
+
+DROP TABLE IF EXISTS user_reminder_slots;
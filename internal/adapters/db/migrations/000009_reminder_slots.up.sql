@@ -0,0 +1,18 @@
+CREATE TABLE user_reminder_slots (
+    user_id TEXT NOT NULL, -- UUID
+    reminder_time TEXT NOT NULL, -- hh:mm in the user's time zone, e.g. 21:00
+    weekdays INTEGER NOT NULL DEFAULT 127, -- bit mask, bit 0 is Sunday and bit 6 is Saturday, 127 means every day
+    PRIMARY KEY (user_id, reminder_time),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- the single reminder time of each user becomes a daily slot
+INSERT INTO user_reminder_slots (user_id, reminder_time)
+    SELECT id, printf('%02d:%02d',
+            CAST(substr(reminder_time, 1, instr(reminder_time, ':') - 1) AS INTEGER),
+            CAST(substr(reminder_time, instr(reminder_time, ':') + 1) AS INTEGER))
+        FROM users;
+
+ALTER TABLE users DROP COLUMN reminder_time;
+ALTER TABLE users ADD COLUMN quiet_hours_start TEXT; -- hh:mm, NULL means no quiet hours
+ALTER TABLE users ADD COLUMN quiet_hours_end TEXT; -- hh:mm, exclusive, earlier than the start if the window wraps midnight
@@ -0,0 +1 @@
+ALTER TABLE users ADD COLUMN last_notified_at TIMESTAMP; -- UTC time of the last reminder slot the user was notified for, NULL if never
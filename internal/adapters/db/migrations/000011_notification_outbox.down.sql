@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS notification_outbox_pending_idx;
+DROP TABLE IF EXISTS notification_outbox;
@@ -0,0 +1,16 @@
+CREATE TABLE notification_outbox (
+    id TEXT PRIMARY KEY, -- UUID
+    user_id TEXT NOT NULL, -- UUID
+    reminder_at TIMESTAMP NOT NULL, -- UTC time of the reminder slot
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, sent, skipped (nothing was due) or failed (given up)
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT, -- error of the last failed attempt
+    next_attempt_at TIMESTAMP NOT NULL, -- UTC
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP,
+    UNIQUE (user_id, reminder_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX notification_outbox_pending_idx ON notification_outbox (status, next_attempt_at);
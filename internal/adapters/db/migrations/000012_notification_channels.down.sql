@@ -0,0 +1,33 @@
+CREATE TABLE notification_outbox_old (
+    id TEXT PRIMARY KEY, -- UUID
+    user_id TEXT NOT NULL, -- UUID
+    reminder_at TIMESTAMP NOT NULL, -- UTC time of the reminder slot
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, sent, skipped (nothing was due) or failed (given up)
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT, -- error of the last failed attempt
+    next_attempt_at TIMESTAMP NOT NULL, -- UTC
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP,
+    UNIQUE (user_id, reminder_at),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+-- only the telegram deliveries are kept, there was no other channel
+INSERT INTO notification_outbox_old (
+    id, user_id, reminder_at, status, attempts, last_error, next_attempt_at, created_at, updated_at, sent_at
+    )
+    SELECT id, user_id, reminder_at, status, attempts, last_error, next_attempt_at, created_at, updated_at, sent_at
+        FROM notification_outbox
+        WHERE channel = 'telegram';
+
+DROP INDEX IF EXISTS notification_outbox_pending_idx;
+DROP TABLE notification_outbox;
+ALTER TABLE notification_outbox_old RENAME TO notification_outbox;
+
+CREATE INDEX notification_outbox_pending_idx ON notification_outbox (status, next_attempt_at);
+
+ALTER TABLE users DROP COLUMN webhook_secret;
+ALTER TABLE users DROP COLUMN webhook_url;
+ALTER TABLE users DROP COLUMN email;
+ALTER TABLE users DROP COLUMN notification_channels;
@@ -0,0 +1,33 @@
+ALTER TABLE users ADD COLUMN notification_channels TEXT NOT NULL DEFAULT 'telegram'; -- comma separated: telegram, email, webhook
+ALTER TABLE users ADD COLUMN email TEXT;
+ALTER TABLE users ADD COLUMN webhook_url TEXT CHECK (webhook_url LIKE 'https://%'); -- only https urls are accepted
+ALTER TABLE users ADD COLUMN webhook_secret TEXT; -- signs the webhook payloads, set while the webhook channel is on
+
+-- a delivery per channel, SQLite can not change the unique constraint in place
+CREATE TABLE notification_outbox_new (
+    id TEXT PRIMARY KEY, -- UUID
+    user_id TEXT NOT NULL, -- UUID
+    channel TEXT NOT NULL DEFAULT 'telegram', -- telegram, email or webhook
+    reminder_at TIMESTAMP NOT NULL, -- UTC time of the reminder slot
+    status TEXT NOT NULL DEFAULT 'pending', -- pending, sent, skipped (nothing was due) or failed (given up)
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT, -- error of the last failed attempt
+    next_attempt_at TIMESTAMP NOT NULL, -- UTC
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    sent_at TIMESTAMP,
+    UNIQUE (user_id, reminder_at, channel),
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+INSERT INTO notification_outbox_new (
+    id, user_id, reminder_at, status, attempts, last_error, next_attempt_at, created_at, updated_at, sent_at
+    )
+    SELECT id, user_id, reminder_at, status, attempts, last_error, next_attempt_at, created_at, updated_at, sent_at
+        FROM notification_outbox;
+
+DROP INDEX IF EXISTS notification_outbox_pending_idx;
+DROP TABLE notification_outbox;
+ALTER TABLE notification_outbox_new RENAME TO notification_outbox;
+
+CREATE INDEX notification_outbox_pending_idx ON notification_outbox (status, next_attempt_at);
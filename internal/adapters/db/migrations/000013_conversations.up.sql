@@ -0,0 +1,9 @@
+-- the state of the multi-step conversations of the bot, a chat has a single conversation at a time
+CREATE TABLE conversations (
+    chat_id INTEGER PRIMARY KEY, -- telegram chat id
+    flow TEXT NOT NULL, -- create_item or edit_item
+    step TEXT NOT NULL, -- the value the bot waits for, e.g. name
+    data TEXT NOT NULL DEFAULT '{}', -- JSON object of the values collected so far
+    expires_at TIMESTAMP NOT NULL, -- UTC
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
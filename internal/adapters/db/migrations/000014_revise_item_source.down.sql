@@ -0,0 +1 @@
+ALTER TABLE revise_items DROP COLUMN source;
@@ -0,0 +1 @@
+ALTER TABLE revise_items ADD COLUMN source TEXT; -- URL of the source, e.g. the article or the telegram message
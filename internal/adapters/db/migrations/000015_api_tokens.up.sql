@@ -0,0 +1,14 @@
+-- personal access tokens of the REST API, only the SHA-256 hash of a token is kept
+CREATE TABLE api_tokens (
+    id TEXT PRIMARY KEY, -- UUID
+    user_id TEXT NOT NULL, -- UUID
+    name TEXT NOT NULL,
+    token_hash BLOB NOT NULL UNIQUE, -- SHA-256 of the token
+    scope TEXT NOT NULL, -- read or write, write includes read
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users(id)
+);
+
+CREATE INDEX api_tokens_user_id_idx ON api_tokens (user_id);
@@ -0,0 +1,25 @@
+-- name: CreateAPIToken :exec
+INSERT INTO api_tokens (
+    id, user_id, name, token_hash, scope, created_at, last_used_at, revoked_at
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? );
+
+-- name: GetAPIToken :one
+SELECT *
+    FROM api_tokens
+    WHERE id = ?;
+
+-- name: GetAPITokenByHash :one
+SELECT *
+    FROM api_tokens
+    WHERE token_hash = ?;
+
+-- name: UpdateAPIToken :exec
+UPDATE api_tokens
+    SET name = ?, scope = ?, last_used_at = ?, revoked_at = ?
+    WHERE id = ?;
+
+-- name: ListUserAPITokens :many
+SELECT *
+    FROM api_tokens
+    WHERE user_id = ? AND revoked_at IS NULL
+    ORDER BY created_at DESC, id;
@@ -0,0 +1,17 @@
+-- name: GetConversation :one
+SELECT *
+    FROM conversations
+    WHERE chat_id = ?;
+
+-- name: SaveConversation :exec
+INSERT INTO conversations (
+    chat_id, flow, step, data, expires_at, updated_at
+    ) VALUES ( ?, ?, ?, ?, ?, ? )
+    ON CONFLICT (chat_id) DO UPDATE
+    SET flow = excluded.flow, step = excluded.step, data = excluded.data,
+        expires_at = excluded.expires_at, updated_at = excluded.updated_at;
+
+-- name: DeleteConversation :exec
+DELETE
+    FROM conversations
+    WHERE chat_id = ?;
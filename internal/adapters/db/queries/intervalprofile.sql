@@ -0,0 +1,56 @@
+
+-- name: CreateIntervalProfile :exec
+INSERT 
+    INTO interval_profiles (
+        id, user_id, name, intervals, created_at, updated_at
+    ) VALUES ( ?, ?, ?, ?, ?, ? );
+
+-- name: GetIntervalProfile :one
+SELECT *
+    FROM interval_profiles
+    WHERE id = ?;
+
+-- name: ListUserIntervalProfiles :many
+SELECT *
+    FROM interval_profiles
+    WHERE user_id = ?
+    ORDER BY name;
+
+-- name: UpdateIntervalProfile :exec
+UPDATE interval_profiles
+    SET name = ?, intervals = ?, updated_at = ?
+    WHERE id = ?;
+
+-- name: DeleteIntervalProfile :exec
+DELETE 
+    FROM interval_profiles
+    WHERE id = ?;
+
+-- name: AttachTagIntervalProfile :exec
+INSERT 
+    INTO interval_profile_tags (
+        user_id, tag, profile_id
+    ) VALUES ( ?, ?, ? )
+    ON CONFLICT (user_id, tag) DO UPDATE SET profile_id = excluded.profile_id;
+
+-- name: DetachTagIntervalProfile :exec
+DELETE 
+    FROM interval_profile_tags
+    WHERE user_id = ? AND tag = ?;
+
+-- name: DeleteIntervalProfileTags :exec
+DELETE 
+    FROM interval_profile_tags
+    WHERE profile_id = ?;
+
+-- name: ListUserTagIntervalProfiles :many
+SELECT interval_profile_tags.tag, interval_profiles.*
+    FROM interval_profile_tags
+    JOIN interval_profiles ON interval_profiles.id = interval_profile_tags.profile_id
+    WHERE interval_profile_tags.user_id = ?
+    ORDER BY interval_profile_tags.tag;
+
+-- name: ClearReviseItemsIntervalProfile :exec
+UPDATE revise_items
+    SET interval_profile_id = NULL
+    WHERE interval_profile_id = ?;
@@ -0,0 +1,23 @@
+
+-- name: CreateDelivery :execrows
+INSERT INTO notification_outbox (
+    id, user_id, channel, reminder_at, status, attempts, last_error, next_attempt_at, created_at, updated_at, sent_at
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
+    ON CONFLICT (user_id, reminder_at, channel) DO NOTHING;
+
+-- name: GetDelivery :one
+SELECT *
+    FROM notification_outbox
+    WHERE id = ?;
+
+-- name: ListPendingDeliveries :many
+SELECT *
+    FROM notification_outbox
+    WHERE status = 'pending' AND next_attempt_at <= ?
+    ORDER BY next_attempt_at
+    LIMIT ?;
+
+-- name: UpdateDelivery :exec
+UPDATE notification_outbox
+    SET status = ?, attempts = ?, last_error = ?, next_attempt_at = ?, updated_at = ?, sent_at = ?
+    WHERE id = ?;
\ No newline at end of file
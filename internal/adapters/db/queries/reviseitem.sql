@@ -3,14 +3,21 @@
 INSERT 
     INTO revise_items (
         id, user_id, name, description, tags,
-        created_at, updated_at, last_revised_at, next_revision_at
-    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? );
+        created_at, updated_at, last_revised_at, next_revision_at,
+        ease, stability, difficulty, repetitions, interval_seconds,
+        interval_profile_id, source
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? );
 
 -- name: GetReviseItem :one
 SELECT * 
     FROM revise_items
     WHERE id = ? AND deleted_at IS NULL;
 
+-- name: GetDeletedReviseItem :one
+SELECT *
+    FROM revise_items
+    WHERE id = ? AND deleted_at IS NOT NULL;
+
 
 -- name: GetUserReviseItems :many
 SELECT * 
@@ -21,8 +28,10 @@ SELECT *
 UPDATE revise_items
     SET 
         name = ?, description = ?, tags = ?, created_at = ?, 
-        updated_at = ?, last_revised_at = ?, next_revision_at = ?
-    WHERE id = ? AND deleted_at IS NULL;
+        updated_at = ?, last_revised_at = ?, next_revision_at = ?,
+        ease = ?, stability = ?, difficulty = ?, repetitions = ?, interval_seconds = ?,
+        interval_profile_id = ?, source = ?, deleted_at = ?
+    WHERE id = ?;
 
 -- name: MarkReviseItemDeleted :exec
 UPDATE revise_items
@@ -35,13 +44,40 @@ DELETE
     WHERE id = ?;
 
 -- name: ListUserReviseItems :many
-SELECT COUNT(*), *
+SELECT COUNT(*) OVER () AS count, *
     FROM revise_items
     WHERE user_id = ? AND deleted_at IS NULL
-    ORDER BY created_at DESC
+    ORDER BY created_at DESC, id
     LIMIT ? OFFSET ?;
 
+-- name: SearchUserReviseItems :many
+-- the pattern is lower case, unicode_lower folds the case of the non-ASCII letters LIKE leaves as they are.
+SELECT COUNT(*) OVER () AS count, *
+    FROM revise_items
+    WHERE user_id = sqlc.arg(user_id) AND deleted_at IS NULL AND (
+        unicode_lower(name) LIKE sqlc.arg(pattern) ESCAPE '\'
+        OR unicode_lower(description) LIKE sqlc.arg(pattern) ESCAPE '\'
+        OR unicode_lower(tags) LIKE sqlc.arg(pattern) ESCAPE '\'
+    )
+    ORDER BY unicode_lower(name) LIKE sqlc.arg(pattern) ESCAPE '\' DESC, next_revision_at, id
+    LIMIT sqlc.arg(limit) OFFSET sqlc.arg(offset);
+
+-- name: ListUserReviseItemTags :many
+SELECT tags
+    FROM revise_items
+    WHERE user_id = ? AND deleted_at IS NULL AND tags IS NOT NULL;
+
 -- name: GetUserReviseItemsByTime :many
 SELECT *
     FROM revise_items
-    WHERE user_id = ? AND deleted_at IS NULL AND next_revision_at <= ?;
\ No newline at end of file
+    WHERE user_id = ? AND deleted_at IS NULL AND next_revision_at <= ?;
+
+-- name: GetUserDueCounts :many
+-- next_revision_at is stored in UTC, its first 19 characters are the UTC date and time
+-- shifted by the utc_offset modifier, e.g. '+18000 seconds', to the local date of the user.
+SELECT CAST(date(substr(next_revision_at, 1, 19), CAST(sqlc.arg(utc_offset) AS TEXT)) AS TEXT) AS due_date,
+        COUNT(*) AS count
+    FROM revise_items
+    WHERE user_id = sqlc.arg(user_id) AND deleted_at IS NULL
+        AND next_revision_at >= sqlc.arg(from_time) AND id != sqlc.arg(exclude_id)
+    GROUP BY due_date;
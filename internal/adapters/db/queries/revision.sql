@@ -2,8 +2,9 @@
 -- name: CreateRevision :exec
 INSERT 
     INTO revisions(
-        id, revise_item_id, revised_at
-    ) VALUES ( ?, ?, ? );
+        id, revise_item_id, revised_at, grade,
+        notes, duration_seconds, confidence, kind
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? );
 
 -- name: GetRevision :one
 SELECT * 
@@ -13,8 +14,30 @@ SELECT *
 -- name: GetRevisionItemRevisions :many
 SELECT * 
     FROM revisions 
+    WHERE revise_item_id = ?
+    ORDER BY revised_at;
+
+-- name: CountReviseItemRevisions :one
+SELECT COUNT(*)
+    FROM revisions
     WHERE revise_item_id = ?;
 
+-- name: GetUserDailyUsage :one
+SELECT
+    (SELECT COUNT(*)
+        FROM revisions r
+        JOIN revise_items ri ON ri.id = r.revise_item_id
+        WHERE ri.user_id = sqlc.arg(user_id) AND r.kind != 'reset' AND r.revised_at >= sqlc.arg(since)
+    ) AS revisions,
+    (SELECT COUNT(*)
+        FROM revise_items ri
+        WHERE ri.user_id = sqlc.arg(user_id) AND (
+            SELECT MIN(r.revised_at)
+                FROM revisions r
+                WHERE r.revise_item_id = ri.id AND r.kind != 'reset'
+        ) >= sqlc.arg(since)
+    ) AS new_items;
+
 -- name: DeleteRevision :exec
 DELETE 
     FROM revisions
@@ -1,8 +1,10 @@
 
 -- name: CreateUser :exec
 INSERT INTO users (
-    id, chat_id, created_at, updated_at, language, reminder_time 
-    ) VALUES ( ?, ?, ?, ?, ?, ?);
+    id, chat_id, created_at, updated_at, language, review_intervals,
+    daily_review_limit, daily_new_item_limit, timezone, quiet_hours_start, quiet_hours_end,
+    notification_channels, email, webhook_url, webhook_secret
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- name: GetUserByID :one
 SELECT *
@@ -16,10 +18,41 @@ SELECT *
 
 -- name: UpdateUser :exec
 UPDATE users
-    SET updated_at = ?, language = ?, reminder_time = ?
+    SET updated_at = ?, language = ?, review_intervals = ?,
+        daily_review_limit = ?, daily_new_item_limit = ?, timezone = ?,
+        quiet_hours_start = ?, quiet_hours_end = ?,
+        notification_channels = ?, email = ?, webhook_url = ?, webhook_secret = ?
     WHERE id = ?;
 
--- name: GetUsersByReminderTime :many
+-- name: ListUsersWithReminderSlots :many
 SELECT *
     FROM users
-    WHERE reminder_time = ?;
\ No newline at end of file
+    WHERE EXISTS (
+        SELECT 1 FROM user_reminder_slots WHERE user_reminder_slots.user_id = users.id
+    );
+
+-- name: ClaimUserNotification :execrows
+UPDATE users
+    SET last_notified_at = sqlc.arg(notified_at)
+    WHERE id = sqlc.arg(id)
+        AND (last_notified_at IS NULL OR last_notified_at < sqlc.arg(notified_at));
+
+-- name: CreateUserReminderSlot :exec
+INSERT INTO user_reminder_slots (
+    user_id, reminder_time, weekdays
+    ) VALUES ( ?, ?, ? );
+
+-- name: ListUserReminderSlots :many
+SELECT *
+    FROM user_reminder_slots
+    WHERE user_id = ?
+    ORDER BY reminder_time;
+
+-- name: ListReminderSlots :many
+SELECT *
+    FROM user_reminder_slots
+    ORDER BY user_id, reminder_time;
+
+-- name: DeleteUserReminderSlots :exec
+DELETE FROM user_reminder_slots
+    WHERE user_id = ?;
\ No newline at end of file
@@ -9,30 +9,107 @@ import (
 	"time"
 )
 
+type ApiToken struct {
+	ID         string
+	UserID     string
+	Name       string
+	TokenHash  []byte
+	Scope      string
+	CreatedAt  time.Time
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+}
+
+type Conversation struct {
+	ChatID    int64
+	Flow      string
+	Step      string
+	Data      string
+	ExpiresAt time.Time
+	UpdatedAt time.Time
+}
+
+type IntervalProfile struct {
+	ID        string
+	UserID    string
+	Name      string
+	Intervals string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type IntervalProfileTag struct {
+	UserID    string
+	Tag       string
+	ProfileID string
+}
+
 type ReviseItem struct {
-	ID             string
-	UserID         string
-	Name           string
-	Description    sql.NullString
-	Tags           sql.NullString
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	DeletedAt      sql.NullTime
-	LastRevisedAt  time.Time
-	NextRevisionAt time.Time
+	ID                string
+	UserID            string
+	Name              string
+	Description       sql.NullString
+	Tags              sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         sql.NullTime
+	LastRevisedAt     time.Time
+	NextRevisionAt    time.Time
+	Ease              float64
+	Stability         float64
+	Difficulty        float64
+	Repetitions       int64
+	IntervalSeconds   int64
+	IntervalProfileID sql.NullString
+	Source            sql.NullString
+}
+
+type NotificationOutbox struct {
+	ID            string
+	UserID        string
+	Channel       string
+	ReminderAt    time.Time
+	Status        string
+	Attempts      int64
+	LastError     sql.NullString
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+	SentAt        sql.NullTime
 }
 
 type Revision struct {
-	ID           string
-	ReviseItemID string
-	RevisedAt    time.Time
+	ID              string
+	ReviseItemID    string
+	RevisedAt       time.Time
+	Grade           int64
+	Notes           sql.NullString
+	DurationSeconds int64
+	Confidence      int64
+	Kind            string
 }
 
 type User struct {
-	ID           string
-	ChatID       int64
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	Language     sql.NullString
+	ID                   string
+	ChatID               int64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Language             sql.NullString
+	ReviewIntervals      sql.NullString
+	DailyReviewLimit     int64
+	DailyNewItemLimit    int64
+	Timezone             string
+	QuietHoursStart      sql.NullString
+	QuietHoursEnd        sql.NullString
+	LastNotifiedAt       sql.NullTime
+	NotificationChannels string
+	Email                sql.NullString
+	WebhookURL           sql.NullString
+	WebhookSecret        sql.NullString
+}
+
+type UserReminderSlot struct {
+	UserID       string
 	ReminderTime string
+	Weekdays     int64
 }
@@ -22,8 +22,39 @@ func (q *Queries) DeleteReviseItem(ctx context.Context, id string) error {
 	return err
 }
 
+const getDeletedReviseItem = `-- name: GetDeletedReviseItem :one
+SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
+    FROM revise_items
+    WHERE id = ? AND deleted_at IS NOT NULL
+`
+
+func (q *Queries) GetDeletedReviseItem(ctx context.Context, id string) (ReviseItem, error) {
+	row := q.db.QueryRowContext(ctx, getDeletedReviseItem, id)
+	var i ReviseItem
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Name,
+		&i.Description,
+		&i.Tags,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.LastRevisedAt,
+		&i.NextRevisionAt,
+		&i.Ease,
+		&i.Stability,
+		&i.Difficulty,
+		&i.Repetitions,
+		&i.IntervalSeconds,
+		&i.IntervalProfileID,
+		&i.Source,
+	)
+	return i, err
+}
+
 const getReviseItem = `-- name: GetReviseItem :one
-SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at 
+SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
     FROM revise_items
     WHERE id = ? AND deleted_at IS NULL
 `
@@ -42,12 +73,70 @@ func (q *Queries) GetReviseItem(ctx context.Context, id string) (ReviseItem, err
 		&i.DeletedAt,
 		&i.LastRevisedAt,
 		&i.NextRevisionAt,
+		&i.Ease,
+		&i.Stability,
+		&i.Difficulty,
+		&i.Repetitions,
+		&i.IntervalSeconds,
+		&i.IntervalProfileID,
+		&i.Source,
 	)
 	return i, err
 }
 
+const getUserDueCounts = `-- name: GetUserDueCounts :many
+SELECT CAST(date(substr(next_revision_at, 1, 19), CAST(?1 AS TEXT)) AS TEXT) AS due_date,
+        COUNT(*) AS count
+    FROM revise_items
+    WHERE user_id = ?2 AND deleted_at IS NULL
+        AND next_revision_at >= ?3 AND id != ?4
+    GROUP BY due_date
+`
+
+type GetUserDueCountsParams struct {
+	UtcOffset string
+	UserID    string
+	FromTime  time.Time
+	ExcludeID string
+}
+
+type GetUserDueCountsRow struct {
+	DueDate string
+	Count   int64
+}
+
+// next_revision_at is stored in UTC, its first 19 characters are the UTC date and time
+// shifted by the utc_offset modifier, e.g. '+18000 seconds', to the local date of the user.
+func (q *Queries) GetUserDueCounts(ctx context.Context, arg GetUserDueCountsParams) ([]GetUserDueCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserDueCounts,
+		arg.UtcOffset,
+		arg.UserID,
+		arg.FromTime,
+		arg.ExcludeID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserDueCountsRow
+	for rows.Next() {
+		var i GetUserDueCountsRow
+		if err := rows.Scan(&i.DueDate, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserReviseItems = `-- name: GetUserReviseItems :many
-SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at 
+SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
     FROM revise_items
     WHERE user_id = ? AND deleted_at IS NULL
 `
@@ -72,6 +161,13 @@ func (q *Queries) GetUserReviseItems(ctx context.Context, userID string) ([]Revi
 			&i.DeletedAt,
 			&i.LastRevisedAt,
 			&i.NextRevisionAt,
+			&i.Ease,
+			&i.Stability,
+			&i.Difficulty,
+			&i.Repetitions,
+			&i.IntervalSeconds,
+			&i.IntervalProfileID,
+			&i.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -87,7 +183,7 @@ func (q *Queries) GetUserReviseItems(ctx context.Context, userID string) ([]Revi
 }
 
 const getUserReviseItemsByTime = `-- name: GetUserReviseItemsByTime :many
-SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at
+SELECT id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
     FROM revise_items
     WHERE user_id = ? AND deleted_at IS NULL AND next_revision_at <= ?
 `
@@ -117,6 +213,13 @@ func (q *Queries) GetUserReviseItemsByTime(ctx context.Context, arg GetUserRevis
 			&i.DeletedAt,
 			&i.LastRevisedAt,
 			&i.NextRevisionAt,
+			&i.Ease,
+			&i.Stability,
+			&i.Difficulty,
+			&i.Repetitions,
+			&i.IntervalSeconds,
+			&i.IntervalProfileID,
+			&i.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -132,10 +235,10 @@ func (q *Queries) GetUserReviseItemsByTime(ctx context.Context, arg GetUserRevis
 }
 
 const listUserReviseItems = `-- name: ListUserReviseItems :many
-SELECT COUNT(*), id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at
+SELECT COUNT(*) OVER () AS count, id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
     FROM revise_items
     WHERE user_id = ? AND deleted_at IS NULL
-    ORDER BY created_at DESC
+    ORDER BY created_at DESC, id
     LIMIT ? OFFSET ?
 `
 
@@ -146,17 +249,24 @@ type ListUserReviseItemsParams struct {
 }
 
 type ListUserReviseItemsRow struct {
-	Count          int64
-	ID             string
-	UserID         string
-	Name           string
-	Description    sql.NullString
-	Tags           sql.NullString
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	DeletedAt      sql.NullTime
-	LastRevisedAt  time.Time
-	NextRevisionAt time.Time
+	Count             int64
+	ID                string
+	UserID            string
+	Name              string
+	Description       sql.NullString
+	Tags              sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         sql.NullTime
+	LastRevisedAt     time.Time
+	NextRevisionAt    time.Time
+	Ease              float64
+	Stability         float64
+	Difficulty        float64
+	Repetitions       int64
+	IntervalSeconds   int64
+	IntervalProfileID sql.NullString
+	Source            sql.NullString
 }
 
 func (q *Queries) ListUserReviseItems(ctx context.Context, arg ListUserReviseItemsParams) ([]ListUserReviseItemsRow, error) {
@@ -180,6 +290,13 @@ func (q *Queries) ListUserReviseItems(ctx context.Context, arg ListUserReviseIte
 			&i.DeletedAt,
 			&i.LastRevisedAt,
 			&i.NextRevisionAt,
+			&i.Ease,
+			&i.Stability,
+			&i.Difficulty,
+			&i.Repetitions,
+			&i.IntervalSeconds,
+			&i.IntervalProfileID,
+			&i.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -194,6 +311,35 @@ func (q *Queries) ListUserReviseItems(ctx context.Context, arg ListUserReviseIte
 	return items, nil
 }
 
+const listUserReviseItemTags = `-- name: ListUserReviseItemTags :many
+SELECT tags
+    FROM revise_items
+    WHERE user_id = ? AND deleted_at IS NULL AND tags IS NOT NULL
+`
+
+func (q *Queries) ListUserReviseItemTags(ctx context.Context, userID string) ([]sql.NullString, error) {
+	rows, err := q.db.QueryContext(ctx, listUserReviseItemTags, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []sql.NullString
+	for rows.Next() {
+		var tags sql.NullString
+		if err := rows.Scan(&tags); err != nil {
+			return nil, err
+		}
+		items = append(items, tags)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markReviseItemDeleted = `-- name: MarkReviseItemDeleted :exec
 UPDATE revise_items
     SET deleted_at = ?
@@ -214,20 +360,29 @@ const saveReviseItem = `-- name: SaveReviseItem :exec
 INSERT 
     INTO revise_items (
         id, user_id, name, description, tags,
-        created_at, updated_at, last_revised_at, next_revision_at
-    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )
+        created_at, updated_at, last_revised_at, next_revision_at,
+        ease, stability, difficulty, repetitions, interval_seconds,
+        interval_profile_id, source
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )
 `
 
 type SaveReviseItemParams struct {
-	ID             string
-	UserID         string
-	Name           string
-	Description    sql.NullString
-	Tags           sql.NullString
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	LastRevisedAt  time.Time
-	NextRevisionAt time.Time
+	ID                string
+	UserID            string
+	Name              string
+	Description       sql.NullString
+	Tags              sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	LastRevisedAt     time.Time
+	NextRevisionAt    time.Time
+	Ease              float64
+	Stability         float64
+	Difficulty        float64
+	Repetitions       int64
+	IntervalSeconds   int64
+	IntervalProfileID sql.NullString
+	Source            sql.NullString
 }
 
 func (q *Queries) SaveReviseItem(ctx context.Context, arg SaveReviseItemParams) error {
@@ -241,27 +396,135 @@ func (q *Queries) SaveReviseItem(ctx context.Context, arg SaveReviseItemParams)
 		arg.UpdatedAt,
 		arg.LastRevisedAt,
 		arg.NextRevisionAt,
+		arg.Ease,
+		arg.Stability,
+		arg.Difficulty,
+		arg.Repetitions,
+		arg.IntervalSeconds,
+		arg.IntervalProfileID,
+		arg.Source,
 	)
 	return err
 }
 
+const searchUserReviseItems = `-- name: SearchUserReviseItems :many
+SELECT COUNT(*) OVER () AS count, id, user_id, name, description, tags, created_at, updated_at, deleted_at, last_revised_at, next_revision_at, ease, stability, difficulty, repetitions, interval_seconds, interval_profile_id, source
+    FROM revise_items
+    WHERE user_id = ? AND deleted_at IS NULL AND (
+        unicode_lower(name) LIKE ? ESCAPE '\'
+        OR unicode_lower(description) LIKE ? ESCAPE '\'
+        OR unicode_lower(tags) LIKE ? ESCAPE '\'
+    )
+    ORDER BY unicode_lower(name) LIKE ? ESCAPE '\' DESC, next_revision_at, id
+    LIMIT ? OFFSET ?
+`
+
+type SearchUserReviseItemsParams struct {
+	UserID  string
+	Pattern string
+	Limit   int64
+	Offset  int64
+}
+
+type SearchUserReviseItemsRow struct {
+	Count             int64
+	ID                string
+	UserID            string
+	Name              string
+	Description       sql.NullString
+	Tags              sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	DeletedAt         sql.NullTime
+	LastRevisedAt     time.Time
+	NextRevisionAt    time.Time
+	Ease              float64
+	Stability         float64
+	Difficulty        float64
+	Repetitions       int64
+	IntervalSeconds   int64
+	IntervalProfileID sql.NullString
+	Source            sql.NullString
+}
+
+// the pattern is lower case, unicode_lower folds the case of the non-ASCII letters LIKE leaves as they are.
+func (q *Queries) SearchUserReviseItems(ctx context.Context, arg SearchUserReviseItemsParams) ([]SearchUserReviseItemsRow, error) {
+	rows, err := q.db.QueryContext(ctx, searchUserReviseItems,
+		arg.UserID,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Pattern,
+		arg.Limit,
+		arg.Offset,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SearchUserReviseItemsRow
+	for rows.Next() {
+		var i SearchUserReviseItemsRow
+		if err := rows.Scan(
+			&i.Count,
+			&i.ID,
+			&i.UserID,
+			&i.Name,
+			&i.Description,
+			&i.Tags,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.LastRevisedAt,
+			&i.NextRevisionAt,
+			&i.Ease,
+			&i.Stability,
+			&i.Difficulty,
+			&i.Repetitions,
+			&i.IntervalSeconds,
+			&i.IntervalProfileID,
+			&i.Source,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateReviseItem = `-- name: UpdateReviseItem :exec
 UPDATE revise_items
     SET 
         name = ?, description = ?, tags = ?, created_at = ?, 
-        updated_at = ?, last_revised_at = ?, next_revision_at = ?
-    WHERE id = ? AND deleted_at IS NULL
+        updated_at = ?, last_revised_at = ?, next_revision_at = ?,
+        ease = ?, stability = ?, difficulty = ?, repetitions = ?, interval_seconds = ?,
+        interval_profile_id = ?, source = ?, deleted_at = ?
+    WHERE id = ?
 `
 
 type UpdateReviseItemParams struct {
-	Name           string
-	Description    sql.NullString
-	Tags           sql.NullString
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
-	LastRevisedAt  time.Time
-	NextRevisionAt time.Time
-	ID             string
+	Name              string
+	Description       sql.NullString
+	Tags              sql.NullString
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	LastRevisedAt     time.Time
+	NextRevisionAt    time.Time
+	Ease              float64
+	Stability         float64
+	Difficulty        float64
+	Repetitions       int64
+	IntervalSeconds   int64
+	IntervalProfileID sql.NullString
+	Source            sql.NullString
+	DeletedAt         sql.NullTime
+	ID                string
 }
 
 func (q *Queries) UpdateReviseItem(ctx context.Context, arg UpdateReviseItemParams) error {
@@ -273,6 +536,14 @@ func (q *Queries) UpdateReviseItem(ctx context.Context, arg UpdateReviseItemPara
 		arg.UpdatedAt,
 		arg.LastRevisedAt,
 		arg.NextRevisionAt,
+		arg.Ease,
+		arg.Stability,
+		arg.Difficulty,
+		arg.Repetitions,
+		arg.IntervalSeconds,
+		arg.IntervalProfileID,
+		arg.Source,
+		arg.DeletedAt,
 		arg.ID,
 	)
 	return err
@@ -7,24 +7,53 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
+const countReviseItemRevisions = `-- name: CountReviseItemRevisions :one
+SELECT COUNT(*)
+    FROM revisions
+    WHERE revise_item_id = ?
+`
+
+func (q *Queries) CountReviseItemRevisions(ctx context.Context, reviseItemID string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countReviseItemRevisions, reviseItemID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createRevision = `-- name: CreateRevision :exec
 INSERT 
     INTO revisions(
-        id, revise_item_id, revised_at
-    ) VALUES ( ?, ?, ? )
+        id, revise_item_id, revised_at, grade,
+        notes, duration_seconds, confidence, kind
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )
 `
 
 type CreateRevisionParams struct {
-	ID           string
-	ReviseItemID string
-	RevisedAt    time.Time
+	ID              string
+	ReviseItemID    string
+	RevisedAt       time.Time
+	Grade           int64
+	Notes           sql.NullString
+	DurationSeconds int64
+	Confidence      int64
+	Kind            string
 }
 
 func (q *Queries) CreateRevision(ctx context.Context, arg CreateRevisionParams) error {
-	_, err := q.db.ExecContext(ctx, createRevision, arg.ID, arg.ReviseItemID, arg.RevisedAt)
+	_, err := q.db.ExecContext(ctx, createRevision,
+		arg.ID,
+		arg.ReviseItemID,
+		arg.RevisedAt,
+		arg.Grade,
+		arg.Notes,
+		arg.DurationSeconds,
+		arg.Confidence,
+		arg.Kind,
+	)
 	return err
 }
 
@@ -51,7 +80,7 @@ func (q *Queries) DeleteRevision(ctx context.Context, id string) error {
 }
 
 const getRevision = `-- name: GetRevision :one
-SELECT id, revise_item_id, revised_at 
+SELECT id, revise_item_id, revised_at, grade, notes, duration_seconds, confidence, kind 
     FROM revisions 
     WHERE id = ?
 `
@@ -59,14 +88,24 @@ SELECT id, revise_item_id, revised_at
 func (q *Queries) GetRevision(ctx context.Context, id string) (Revision, error) {
 	row := q.db.QueryRowContext(ctx, getRevision, id)
 	var i Revision
-	err := row.Scan(&i.ID, &i.ReviseItemID, &i.RevisedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.ReviseItemID,
+		&i.RevisedAt,
+		&i.Grade,
+		&i.Notes,
+		&i.DurationSeconds,
+		&i.Confidence,
+		&i.Kind,
+	)
 	return i, err
 }
 
 const getRevisionItemRevisions = `-- name: GetRevisionItemRevisions :many
-SELECT id, revise_item_id, revised_at 
+SELECT id, revise_item_id, revised_at, grade, notes, duration_seconds, confidence, kind 
     FROM revisions 
     WHERE revise_item_id = ?
+    ORDER BY revised_at
 `
 
 func (q *Queries) GetRevisionItemRevisions(ctx context.Context, reviseItemID string) ([]Revision, error) {
@@ -78,7 +117,16 @@ func (q *Queries) GetRevisionItemRevisions(ctx context.Context, reviseItemID str
 	var items []Revision
 	for rows.Next() {
 		var i Revision
-		if err := rows.Scan(&i.ID, &i.ReviseItemID, &i.RevisedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.ReviseItemID,
+			&i.RevisedAt,
+			&i.Grade,
+			&i.Notes,
+			&i.DurationSeconds,
+			&i.Confidence,
+			&i.Kind,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -91,3 +139,37 @@ func (q *Queries) GetRevisionItemRevisions(ctx context.Context, reviseItemID str
 	}
 	return items, nil
 }
+
+const getUserDailyUsage = `-- name: GetUserDailyUsage :one
+SELECT
+    (SELECT COUNT(*)
+        FROM revisions r
+        JOIN revise_items ri ON ri.id = r.revise_item_id
+        WHERE ri.user_id = ?1 AND r.kind != 'reset' AND r.revised_at >= ?2
+    ) AS revisions,
+    (SELECT COUNT(*)
+        FROM revise_items ri
+        WHERE ri.user_id = ?1 AND (
+            SELECT MIN(r.revised_at)
+                FROM revisions r
+                WHERE r.revise_item_id = ri.id AND r.kind != 'reset'
+        ) >= ?2
+    ) AS new_items
+`
+
+type GetUserDailyUsageParams struct {
+	UserID string
+	Since  time.Time
+}
+
+type GetUserDailyUsageRow struct {
+	Revisions int64
+	NewItems  int64
+}
+
+func (q *Queries) GetUserDailyUsage(ctx context.Context, arg GetUserDailyUsageParams) (GetUserDailyUsageRow, error) {
+	row := q.db.QueryRowContext(ctx, getUserDailyUsage, arg.UserID, arg.Since)
+	var i GetUserDailyUsageRow
+	err := row.Scan(&i.Revisions, &i.NewItems)
+	return i, err
+}
@@ -11,19 +11,50 @@ import (
 	"time"
 )
 
+const claimUserNotification = `-- name: ClaimUserNotification :execrows
+UPDATE users
+    SET last_notified_at = ?
+    WHERE id = ?
+        AND (last_notified_at IS NULL OR last_notified_at < ?)
+`
+
+type ClaimUserNotificationParams struct {
+	NotifiedAt sql.NullTime
+	ID         string
+}
+
+func (q *Queries) ClaimUserNotification(ctx context.Context, arg ClaimUserNotificationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimUserNotification, arg.NotifiedAt, arg.ID, arg.NotifiedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createUser = `-- name: CreateUser :exec
 INSERT INTO users (
-    id, chat_id, created_at, updated_at, language, reminder_time 
-    ) VALUES ( ?, ?, ?, ?, ?, ?)
+    id, chat_id, created_at, updated_at, language, review_intervals,
+    daily_review_limit, daily_new_item_limit, timezone, quiet_hours_start, quiet_hours_end,
+    notification_channels, email, webhook_url, webhook_secret
+    ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateUserParams struct {
-	ID           string
-	ChatID       int64
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
-	Language     sql.NullString
-	ReminderTime string
+	ID                   string
+	ChatID               int64
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+	Language             sql.NullString
+	ReviewIntervals      sql.NullString
+	DailyReviewLimit     int64
+	DailyNewItemLimit    int64
+	Timezone             string
+	QuietHoursStart      sql.NullString
+	QuietHoursEnd        sql.NullString
+	NotificationChannels string
+	Email                sql.NullString
+	WebhookURL           sql.NullString
+	WebhookSecret        sql.NullString
 }
 
 func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
@@ -33,13 +64,49 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) error {
 		arg.CreatedAt,
 		arg.UpdatedAt,
 		arg.Language,
-		arg.ReminderTime,
+		arg.ReviewIntervals,
+		arg.DailyReviewLimit,
+		arg.DailyNewItemLimit,
+		arg.Timezone,
+		arg.QuietHoursStart,
+		arg.QuietHoursEnd,
+		arg.NotificationChannels,
+		arg.Email,
+		arg.WebhookURL,
+		arg.WebhookSecret,
 	)
 	return err
 }
 
+const createUserReminderSlot = `-- name: CreateUserReminderSlot :exec
+INSERT INTO user_reminder_slots (
+    user_id, reminder_time, weekdays
+    ) VALUES ( ?, ?, ? )
+`
+
+type CreateUserReminderSlotParams struct {
+	UserID       string
+	ReminderTime string
+	Weekdays     int64
+}
+
+func (q *Queries) CreateUserReminderSlot(ctx context.Context, arg CreateUserReminderSlotParams) error {
+	_, err := q.db.ExecContext(ctx, createUserReminderSlot, arg.UserID, arg.ReminderTime, arg.Weekdays)
+	return err
+}
+
+const deleteUserReminderSlots = `-- name: DeleteUserReminderSlots :exec
+DELETE FROM user_reminder_slots
+    WHERE user_id = ?
+`
+
+func (q *Queries) DeleteUserReminderSlots(ctx context.Context, userID string) error {
+	_, err := q.db.ExecContext(ctx, deleteUserReminderSlots, userID)
+	return err
+}
+
 const getUserByChatID = `-- name: GetUserByChatID :one
-SELECT id, chat_id, created_at, updated_at, language, reminder_time
+SELECT id, chat_id, created_at, updated_at, language, review_intervals, daily_review_limit, daily_new_item_limit, timezone, quiet_hours_start, quiet_hours_end, last_notified_at, notification_channels, email, webhook_url, webhook_secret
     FROM users
     WHERE chat_id = ?
 `
@@ -53,13 +120,23 @@ func (q *Queries) GetUserByChatID(ctx context.Context, chatID int64) (User, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Language,
-		&i.ReminderTime,
+		&i.ReviewIntervals,
+		&i.DailyReviewLimit,
+		&i.DailyNewItemLimit,
+		&i.Timezone,
+		&i.QuietHoursStart,
+		&i.QuietHoursEnd,
+		&i.LastNotifiedAt,
+		&i.NotificationChannels,
+		&i.Email,
+		&i.WebhookURL,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, chat_id, created_at, updated_at, language, reminder_time
+SELECT id, chat_id, created_at, updated_at, language, review_intervals, daily_review_limit, daily_new_item_limit, timezone, quiet_hours_start, quiet_hours_end, last_notified_at, notification_channels, email, webhook_url, webhook_secret
     FROM users
     WHERE id = ?
 `
@@ -73,19 +150,90 @@ func (q *Queries) GetUserByID(ctx context.Context, id string) (User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.Language,
-		&i.ReminderTime,
+		&i.ReviewIntervals,
+		&i.DailyReviewLimit,
+		&i.DailyNewItemLimit,
+		&i.Timezone,
+		&i.QuietHoursStart,
+		&i.QuietHoursEnd,
+		&i.LastNotifiedAt,
+		&i.NotificationChannels,
+		&i.Email,
+		&i.WebhookURL,
+		&i.WebhookSecret,
 	)
 	return i, err
 }
 
-const getUsersByReminderTime = `-- name: GetUsersByReminderTime :many
-SELECT id, chat_id, created_at, updated_at, language, reminder_time
+const listReminderSlots = `-- name: ListReminderSlots :many
+SELECT user_id, reminder_time, weekdays
+    FROM user_reminder_slots
+    ORDER BY user_id, reminder_time
+`
+
+func (q *Queries) ListReminderSlots(ctx context.Context) ([]UserReminderSlot, error) {
+	rows, err := q.db.QueryContext(ctx, listReminderSlots)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserReminderSlot
+	for rows.Next() {
+		var i UserReminderSlot
+		if err := rows.Scan(&i.UserID, &i.ReminderTime, &i.Weekdays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserReminderSlots = `-- name: ListUserReminderSlots :many
+SELECT user_id, reminder_time, weekdays
+    FROM user_reminder_slots
+    WHERE user_id = ?
+    ORDER BY reminder_time
+`
+
+func (q *Queries) ListUserReminderSlots(ctx context.Context, userID string) ([]UserReminderSlot, error) {
+	rows, err := q.db.QueryContext(ctx, listUserReminderSlots, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []UserReminderSlot
+	for rows.Next() {
+		var i UserReminderSlot
+		if err := rows.Scan(&i.UserID, &i.ReminderTime, &i.Weekdays); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsersWithReminderSlots = `-- name: ListUsersWithReminderSlots :many
+SELECT id, chat_id, created_at, updated_at, language, review_intervals, daily_review_limit, daily_new_item_limit, timezone, quiet_hours_start, quiet_hours_end, last_notified_at, notification_channels, email, webhook_url, webhook_secret
     FROM users
-    WHERE reminder_time = ?
+    WHERE EXISTS (
+        SELECT 1 FROM user_reminder_slots WHERE user_reminder_slots.user_id = users.id
+    )
 `
 
-func (q *Queries) GetUsersByReminderTime(ctx context.Context, reminderTime string) ([]User, error) {
-	rows, err := q.db.QueryContext(ctx, getUsersByReminderTime, reminderTime)
+func (q *Queries) ListUsersWithReminderSlots(ctx context.Context) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsersWithReminderSlots)
 	if err != nil {
 		return nil, err
 	}
@@ -99,7 +247,17 @@ func (q *Queries) GetUsersByReminderTime(ctx context.Context, reminderTime strin
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.Language,
-			&i.ReminderTime,
+			&i.ReviewIntervals,
+			&i.DailyReviewLimit,
+			&i.DailyNewItemLimit,
+			&i.Timezone,
+			&i.QuietHoursStart,
+			&i.QuietHoursEnd,
+			&i.LastNotifiedAt,
+			&i.NotificationChannels,
+			&i.Email,
+			&i.WebhookURL,
+			&i.WebhookSecret,
 		); err != nil {
 			return nil, err
 		}
@@ -116,22 +274,43 @@ func (q *Queries) GetUsersByReminderTime(ctx context.Context, reminderTime strin
 
 const updateUser = `-- name: UpdateUser :exec
 UPDATE users
-    SET updated_at = ?, language = ?, reminder_time = ?
+    SET updated_at = ?, language = ?, review_intervals = ?,
+        daily_review_limit = ?, daily_new_item_limit = ?, timezone = ?,
+        quiet_hours_start = ?, quiet_hours_end = ?,
+        notification_channels = ?, email = ?, webhook_url = ?, webhook_secret = ?
     WHERE id = ?
 `
 
 type UpdateUserParams struct {
-	UpdatedAt    time.Time
-	Language     sql.NullString
-	ReminderTime string
-	ID           string
+	UpdatedAt            time.Time
+	Language             sql.NullString
+	ReviewIntervals      sql.NullString
+	DailyReviewLimit     int64
+	DailyNewItemLimit    int64
+	Timezone             string
+	QuietHoursStart      sql.NullString
+	QuietHoursEnd        sql.NullString
+	NotificationChannels string
+	Email                sql.NullString
+	WebhookURL           sql.NullString
+	WebhookSecret        sql.NullString
+	ID                   string
 }
 
 func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) error {
 	_, err := q.db.ExecContext(ctx, updateUser,
 		arg.UpdatedAt,
 		arg.Language,
-		arg.ReminderTime,
+		arg.ReviewIntervals,
+		arg.DailyReviewLimit,
+		arg.DailyNewItemLimit,
+		arg.Timezone,
+		arg.QuietHoursStart,
+		arg.QuietHoursEnd,
+		arg.NotificationChannels,
+		arg.Email,
+		arg.WebhookURL,
+		arg.WebhookSecret,
 		arg.ID,
 	)
 	return err
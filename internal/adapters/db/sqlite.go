@@ -2,16 +2,18 @@ package db
 
 import (
 	"database/sql"
+	"database/sql/driver"
 	"embed"
 	"errors"
 	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/golang-migrate/migrate/v4"
 	"github.com/golang-migrate/migrate/v4/database/sqlite"
 	"github.com/golang-migrate/migrate/v4/source/iofs"
-	_ "modernc.org/sqlite"
+	sqlitedriver "modernc.org/sqlite"
 
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
@@ -19,6 +21,22 @@ import (
 //go:embed migrations/*
 var MigrationsFS embed.FS
 
+func init() {
+	// the built-in lower and LIKE fold only the ASCII letters, unicode_lower folds the Cyrillic ones as well
+	sqlitedriver.MustRegisterDeterministicScalarFunction("unicode_lower", 1, unicodeLower)
+}
+
+func unicodeLower(_ *sqlitedriver.FunctionContext, args []driver.Value) (driver.Value, error) {
+	switch arg := args[0].(type) {
+	case string:
+		return strings.ToLower(arg), nil
+	case []byte:
+		return strings.ToLower(string(arg)), nil
+	default:
+		return arg, nil
+	}
+}
+
 func NewSqlite(filePath string) (*sql.DB, error) {
 	op := errs.Op("adapters.db.sqlite.new_sqlite")
 
@@ -0,0 +1,153 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Notifier sends the due revise items by email through an SMTP server.
+type Notifier struct {
+	cfg config.SMTP
+	now func() time.Time
+}
+
+func NewNotifier(cfg config.SMTP) Notifier {
+	return Notifier{cfg: cfg, now: time.Now}
+}
+
+// Notify emails the due revise items to the email address of the user.
+func (n Notifier) Notify(ctx context.Context, user domainUser.User, reviseItems []reviseitem.ReviseItem) error {
+	op := errs.Op("adapters.email.notify")
+	to := user.Settings().Email
+	if to == "" {
+		return errs.
+			NewIncorrectInputError(op, domainUser.ErrInvalidSettings, "email is not set").
+			WithContext("user_id", user.ID())
+	}
+
+	msg, err := n.message(to, reviseItems)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to build message")
+	}
+	if err := n.send(ctx, to, msg); err != nil {
+		return errs.
+			NewUnknownError(op, err, "failed to send email").
+			WithContext("user_id", user.ID())
+	}
+	return nil
+}
+
+// send delivers the message in a single SMTP session, the TLS is used whenever the server offers it.
+func (n Notifier) send(ctx context.Context, to string, msg []byte) error {
+	if n.cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, n.cfg.Timeout)
+		defer cancel()
+	}
+
+	addr := net.JoinHostPort(n.cfg.Host, strconv.Itoa(n.cfg.Port))
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return err
+		}
+	}
+
+	client, err := smtp.NewClient(conn, n.cfg.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.cfg.Host}); err != nil {
+			return err
+		}
+	}
+	if n.cfg.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.cfg.Username, n.cfg.Password, n.cfg.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.cfg.From); err != nil {
+		return err
+	}
+	if err := client.Rcpt(to); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(msg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// message builds a plain text email listing the due revise items.
+func (n Notifier) message(to string, reviseItems []reviseitem.ReviseItem) ([]byte, error) {
+	subject := fmt.Sprintf("You have %d revise items due", len(reviseItems))
+
+	var msg bytes.Buffer
+	msg.WriteString("From: " + n.cfg.From + "\r\n")
+	msg.WriteString("To: " + to + "\r\n")
+	msg.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", subject) + "\r\n")
+	msg.WriteString("Date: " + n.now().Format(time.RFC1123Z) + "\r\n")
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	msg.WriteString("Content-Transfer-Encoding: quoted-printable\r\n")
+	msg.WriteString("\r\n")
+
+	body := quotedprintable.NewWriter(&msg)
+	if _, err := body.Write([]byte(textBody(reviseItems))); err != nil {
+		return nil, err
+	}
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+	return msg.Bytes(), nil
+}
+
+func textBody(reviseItems []reviseitem.ReviseItem) string {
+	body := strings.Builder{}
+	body.WriteString("Hello!\r\n\r\n")
+	body.WriteString(fmt.Sprintf("You have %d revise items due:\r\n\r\n", len(reviseItems)))
+	for i := range reviseItems {
+		item := &reviseItems[i]
+		body.WriteString(fmt.Sprintf("%d. %s\r\n", i+1, item.Name()))
+		if item.Description() != "" {
+			body.WriteString(fmt.Sprintf("   %s\r\n", item.Description()))
+		}
+		tags := item.Tags()
+		if !tags.IsEmpty() {
+			body.WriteString(fmt.Sprintf("   Tags: %s\r\n", tags.String()))
+		}
+	}
+	body.WriteString("\r\nOpen the bot to review them.\r\n")
+	return body.String()
+}
@@ -0,0 +1,168 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/pointers"
+)
+
+func TestNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	server := newSMTPServer(t)
+	notifier := NewNotifier(config.SMTP{
+		Host:    "127.0.0.1",
+		Port:    server.port,
+		From:    "bot@revise.example",
+		Timeout: 5 * time.Second,
+	})
+
+	item, err := reviseitem.NewReviseItem(reviseitem.NewReviseItemArgs{
+		ID:          uuid.Must(uuid.NewV7()),
+		UserID:      uuid.Must(uuid.NewV7()),
+		Name:        "Go memory model",
+		Description: "Happens-before — the rules",
+		Tags:        valueobject.NewTags("go"),
+	})
+	require.NoError(t, err)
+
+	err = notifier.Notify(context.Background(), newUser(t, "learner@example.com"), []reviseitem.ReviseItem{*item})
+	require.NoError(t, err)
+
+	got := <-server.received
+	t.Run("Expect envelope", func(t *testing.T) {
+		assert.Equal(t, "<bot@revise.example>", got.from)
+		assert.Equal(t, "<learner@example.com>", got.to)
+	})
+	t.Run("Expect message", func(t *testing.T) {
+		msg, err := mail.ReadMessage(strings.NewReader(got.data))
+		require.NoError(t, err)
+		assert.Equal(t, "learner@example.com", msg.Header.Get("To"))
+
+		body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "1. Go memory model")
+		assert.Contains(t, string(body), "Happens-before — the rules")
+		assert.Contains(t, string(body), "Tags: go")
+	})
+}
+
+func TestNotifier_Notify_NoEmail(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewNotifier(config.SMTP{Host: "127.0.0.1", Port: 1})
+	err := notifier.Notify(context.Background(), newUser(t, ""), nil)
+	assert.ErrorIs(t, err, user.ErrInvalidSettings)
+}
+
+func newUser(t *testing.T, email string) user.User {
+	t.Helper()
+
+	settings, err := user.NewSettings(
+		pointers.New(language.English),
+		user.DefaultReminderTime(),
+		user.WithEmail(email),
+	)
+	require.NoError(t, err)
+	u, err := user.NewUser(uuid.Must(uuid.NewV7()), user.TelegramID(42), user.WithSettings(settings))
+	require.NoError(t, err)
+	return *u
+}
+
+type smtpMessage struct {
+	from, to, data string
+}
+
+// smtpServer is a local SMTP stand-in, it accepts a single message without TLS or auth.
+type smtpServer struct {
+	port     int
+	received chan smtpMessage
+}
+
+func newSMTPServer(t *testing.T) *smtpServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	_, port, err := net.SplitHostPort(ln.Addr().String())
+	require.NoError(t, err)
+	s := &smtpServer{received: make(chan smtpMessage, 1)}
+	s.port, err = strconv.Atoi(port)
+	require.NoError(t, err)
+
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		s.serve(conn)
+	}()
+	return s
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	var msg smtpMessage
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimSpace(line)
+		switch upper := strings.ToUpper(cmd); {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			msg.from = cmd[len("MAIL FROM:"):]
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			msg.to = cmd[len("RCPT TO:"):]
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			data := strings.Builder{}
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			msg.data = data.String()
+			reply("250 OK")
+		case upper == "QUIT":
+			reply("221 Bye")
+			s.received <- msg
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/safehttp"
+)
+
+const (
+	// TimestampHeader is the unix time the payload was signed at.
+	TimestampHeader = "X-Revise-Timestamp"
+	// SignatureHeader is "sha256=" followed by the hex HMAC-SHA256 of "<timestamp>.<body>",
+	// keyed with the webhook secret of the user.
+	SignatureHeader = "X-Revise-Signature"
+
+	// EventReminder is the event of the payload with the due revise items.
+	EventReminder = "reminder"
+)
+
+// Payload is the JSON body posted to the webhook url of the user.
+type Payload struct {
+	Event  string    `json:"event"`
+	UserID string    `json:"user_id"`
+	SentAt time.Time `json:"sent_at"`
+	Items  []Item    `json:"items"`
+}
+
+type Item struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Description    string    `json:"description"`
+	Tags           []string  `json:"tags"`
+	Source         string    `json:"source,omitempty"`
+	NextRevisionAt time.Time `json:"next_revision_at"`
+}
+
+// Notifier posts the due revise items as a JSON payload signed with the webhook secret of the user
+// to the webhook url of the user. The urls are given by the users, so only the public addresses
+// are posted to and the redirects are not followed.
+type Notifier struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func NewNotifier(cfg config.Webhook) Notifier {
+	client := safehttp.NewClient(cfg.Timeout)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return Notifier{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// Notify posts the due revise items to the webhook url of the user, any status other than 2xx is an error.
+func (n Notifier) Notify(ctx context.Context, user domainUser.User, reviseItems []reviseitem.ReviseItem) error {
+	op := errs.Op("adapters.webhook.notify")
+	url, secret := user.Settings().WebhookURL, user.Settings().WebhookSecret
+	if url == "" || secret == "" {
+		return errs.
+			NewIncorrectInputError(op, domainUser.ErrInvalidSettings, "webhook url is not set").
+			WithContext("user_id", user.ID())
+	}
+
+	now := n.now()
+	body, err := json.Marshal(newPayload(user, reviseItems, now))
+	if err != nil {
+		return errs.WithOp(op, err, "failed to marshal payload")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create request")
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, Sign([]byte(secret), timestamp, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return errs.NewUnknownError(op, err, "failed to post webhook").WithContext("user_id", user.ID())
+	}
+	defer resp.Body.Close()
+	// drain the body, so the connection is reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errs.
+			NewUnknownError(op, nil, "webhook responded with an error status").
+			WithContext("user_id", user.ID()).
+			WithContext("status", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header of the body sent at the timestamp,
+// the receivers compute it with the webhook secret shown to them to verify the payload.
+func Sign(secret []byte, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func newPayload(user domainUser.User, reviseItems []reviseitem.ReviseItem, now time.Time) Payload {
+	items := make([]Item, len(reviseItems))
+	for i := range reviseItems {
+		item := &reviseItems[i]
+		tags := item.Tags()
+		items[i] = Item{
+			ID:             item.ID().String(),
+			Name:           item.Name(),
+			Description:    item.Description(),
+			Source:         item.Source(),
+			Tags:           append([]string{}, tags.StringArray()...),
+			NextRevisionAt: item.NextRevisionAt(),
+		}
+	}
+	return Payload{
+		Event:  EventReminder,
+		UserID: user.ID().String(),
+		SentAt: now.UTC(),
+		Items:  items,
+	}
+}
@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/pointers"
+	"github.com/ARUMANDESU/go-revise/pkg/safehttp"
+)
+
+const secret = user.WebhookSecretPrefix + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestNotifier_Notify(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan request, 1)
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- request{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	item, err := reviseitem.NewReviseItem(reviseitem.NewReviseItemArgs{
+		ID:     uuid.Must(uuid.NewV7()),
+		UserID: uuid.Must(uuid.NewV7()),
+		Name:   "Go memory model",
+		Tags:   valueobject.NewTags("go", "concurrency"),
+	})
+	require.NoError(t, err)
+
+	u := newUser(t, server.URL)
+	notifier := newTestNotifier(server)
+	require.NoError(t, notifier.Notify(context.Background(), u, []reviseitem.ReviseItem{*item}))
+
+	got := <-received
+	t.Run("Expect valid signature", func(t *testing.T) {
+		timestamp := got.header.Get(TimestampHeader)
+		require.NotEmpty(t, timestamp)
+		assert.Equal(t, Sign([]byte(secret), timestamp, got.body), got.header.Get(SignatureHeader))
+	})
+	t.Run("Expect payload", func(t *testing.T) {
+		var payload Payload
+		require.NoError(t, json.Unmarshal(got.body, &payload))
+		assert.Equal(t, EventReminder, payload.Event)
+		assert.Equal(t, u.ID().String(), payload.UserID)
+		require.Len(t, payload.Items, 1)
+		assert.Equal(t, item.ID().String(), payload.Items[0].ID)
+		assert.Equal(t, "Go memory model", payload.Items[0].Name)
+		assert.ElementsMatch(t, []string{"go", "concurrency"}, payload.Items[0].Tags)
+	})
+}
+
+func TestNotifier_Notify_ErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(server.Close)
+
+	notifier := newTestNotifier(server)
+	err := notifier.Notify(context.Background(), newUser(t, server.URL), nil)
+	assert.Error(t, err)
+}
+
+func TestNotifier_Notify_Redirect(t *testing.T) {
+	t.Parallel()
+
+	var redirected bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hooks", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/internal", func(w http.ResponseWriter, _ *http.Request) {
+		redirected = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+
+	err := newTestNotifier(server).Notify(context.Background(), newUser(t, server.URL+"/hooks"), nil)
+	assert.Error(t, err)
+	assert.False(t, redirected, "the redirect must not be followed")
+}
+
+func TestNotifier_Notify_PrivateAddress(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+
+	notifier := NewNotifier(config.Webhook{Timeout: 5 * time.Second})
+	for _, url := range []string{server.URL, "https://169.254.169.254/latest/meta-data/", "https://localhost:1/"} {
+		t.Run(url, func(t *testing.T) {
+			err := notifier.Notify(context.Background(), newUser(t, url), nil)
+			assert.ErrorIs(t, err, safehttp.ErrForbiddenAddress)
+		})
+	}
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"event":"reminder"}`)
+	signature := Sign([]byte(secret), "1700000000", body)
+
+	assert.Equal(t, signature, Sign([]byte(secret), "1700000000", body))
+	assert.NotEqual(t, signature, Sign([]byte(secret), "1700000001", body))
+	assert.NotEqual(t, signature, Sign([]byte("other"), "1700000000", body))
+}
+
+// newTestNotifier returns the notifier posting to the test server,
+// it trusts the certificate of the server and reaches the loopback the notifier refuses.
+func newTestNotifier(server *httptest.Server) Notifier {
+	notifier := NewNotifier(config.Webhook{Timeout: 5 * time.Second})
+	client := server.Client()
+	client.CheckRedirect = notifier.client.CheckRedirect
+	notifier.client = client
+	return notifier
+}
+
+func newUser(t *testing.T, webhookURL string) user.User {
+	t.Helper()
+
+	settings, err := user.NewSettings(
+		pointers.New(language.English),
+		user.DefaultReminderTime(),
+		user.WithWebhookURL(webhookURL),
+		user.WithWebhookSecret(secret),
+	)
+	require.NoError(t, err)
+	u, err := user.NewUser(uuid.Must(uuid.NewV7()), user.TelegramID(42), user.WithSettings(settings))
+	require.NoError(t, err)
+	return *u
+}
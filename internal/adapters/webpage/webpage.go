@@ -0,0 +1,73 @@
+// Package webpage fetches the titles of the web pages the revise items are created from.
+package webpage
+
+import (
+	"context"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/safehttp"
+)
+
+// maxHeadBytes is how much of the page is read looking for the title, it is in the head of the page.
+const maxHeadBytes = 256 << 10
+
+var titleRx = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+
+// TitleFetcher fetches the title of the web page.
+// The pages are given by the users, so only the public addresses are fetched.
+type TitleFetcher struct {
+	client *http.Client
+}
+
+func NewTitleFetcher(cfg config.Webpage) TitleFetcher {
+	return TitleFetcher{client: safehttp.NewClient(cfg.Timeout)}
+}
+
+// Title returns the title of the HTML page at the URL, empty if the page has none.
+func (f TitleFetcher) Title(ctx context.Context, url string) (string, error) {
+	op := errs.Op("adapters.webpage.title")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", errs.NewIncorrectInputError(op, err, "failed to create request").WithContext("url", url)
+	}
+	req.Header.Set("Accept", "text/html")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return "", errs.NewUnknownError(op, err, "failed to get page").WithContext("url", url)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return "", errs.
+			NewUnknownError(op, errs.ErrInvalidInput, "unexpected status").
+			WithContext("url", url).
+			WithContext("status", resp.StatusCode)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type")); mediaType != "text/html" {
+		return "", nil
+	}
+
+	head, err := io.ReadAll(io.LimitReader(resp.Body, maxHeadBytes))
+	if err != nil {
+		return "", errs.NewUnknownError(op, err, "failed to read page").WithContext("url", url)
+	}
+	return ParseTitle(head), nil
+}
+
+// ParseTitle returns the title of the HTML page, empty if it has none.
+func ParseTitle(page []byte) string {
+	match := titleRx.FindSubmatch(page)
+	if match == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(html.UnescapeString(string(match[1]))), " ")
+}
@@ -0,0 +1,82 @@
+package webpage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/pkg/safehttp"
+)
+
+func TestTitleFetcher_Title(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte(`<html><head><title>
+			Go Memory Model &amp; Races
+		</title></head><body></body></html>`))
+	})
+	mux.HandleFunc("/file", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/pdf")
+		_, _ = w.Write([]byte(`<title>not a page</title>`))
+	})
+	mux.HandleFunc("/missing", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	// the test server listens on the loopback the fetcher refuses
+	fetcher := TitleFetcher{client: server.Client()}
+
+	t.Run("Expect the title of the page", func(t *testing.T) {
+		title, err := fetcher.Title(context.Background(), server.URL+"/article")
+		require.NoError(t, err)
+		assert.Equal(t, "Go Memory Model & Races", title)
+	})
+	t.Run("Expect no title of not an HTML page", func(t *testing.T) {
+		title, err := fetcher.Title(context.Background(), server.URL+"/file")
+		require.NoError(t, err)
+		assert.Empty(t, title)
+	})
+	t.Run("Expect error status to fail", func(t *testing.T) {
+		_, err := fetcher.Title(context.Background(), server.URL+"/missing")
+		assert.Error(t, err)
+	})
+}
+
+func TestTitleFetcher_Title_PrivateAddress(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		_, _ = w.Write([]byte(`<title>internal</title>`))
+	}))
+	t.Cleanup(server.Close)
+
+	fetcher := NewTitleFetcher(config.Webpage{Timeout: 5 * time.Second})
+
+	for _, url := range []string{server.URL, "http://169.254.169.254/latest/meta-data/", "http://localhost:1/"} {
+		t.Run(url, func(t *testing.T) {
+			title, err := fetcher.Title(context.Background(), url)
+			assert.ErrorIs(t, err, safehttp.ErrForbiddenAddress)
+			assert.Empty(t, title)
+		})
+	}
+}
+
+func TestParseTitle(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Spaced repetition - Wikipedia",
+		ParseTitle([]byte(`<head><TITLE lang="en">Spaced repetition - Wikipedia</TITLE></head>`)))
+	assert.Empty(t, ParseTitle([]byte(`<head></head>`)))
+}
@@ -0,0 +1,21 @@
+package apitoken
+
+import (
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	CreateToken  command.CreateTokenHandler
+	RevokeToken  command.RevokeTokenHandler
+	Authenticate command.AuthenticateHandler
+}
+
+type Queries struct {
+	ListUserTokens query.ListUserTokensHandler
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Authenticate looks up the user of an api token sent as a Bearer token.
+type Authenticate struct {
+	Token string `json:"-"`
+}
+
+// Authenticated is the user the api token belongs to and what it is allowed to do.
+type Authenticated struct {
+	TokenID uuid.UUID
+	UserID  uuid.UUID
+	Scope   apitoken.Scope
+}
+
+type AuthenticateHandler struct {
+	repo apitoken.Repository
+}
+
+func NewAuthenticateHandler(repo apitoken.Repository) AuthenticateHandler {
+	return AuthenticateHandler{repo: repo}
+}
+
+// Handle returns an authorization error for the unknown and revoked tokens,
+// the last use of the token is recorded.
+func (h *AuthenticateHandler) Handle(ctx context.Context, cmd Authenticate) (Authenticated, error) {
+	op := errs.Op("application.apitoken.command.authenticate")
+	if !strings.HasPrefix(cmd.Token, apitoken.Prefix) {
+		return Authenticated{}, invalidToken(op, nil)
+	}
+
+	token, err := h.repo.GetByHash(ctx, apitoken.Hash(cmd.Token))
+	if err != nil {
+		if errs.IsErrorType(err, errs.ErrorTypeNotFound) {
+			return Authenticated{}, invalidToken(op, err)
+		}
+		return Authenticated{}, errs.WithOp(op, err, "failed to get api token")
+	}
+	if token.IsRevoked() {
+		return Authenticated{}, invalidToken(op, nil).WithContext("token_id", token.ID())
+	}
+
+	if token.MarkUsed(time.Now()) {
+		err = h.repo.Update(ctx, token.ID(), func(t *apitoken.Token) (*apitoken.Token, error) {
+			t.MarkUsed(token.LastUsedAt())
+			return t, nil
+		})
+		if err != nil {
+			return Authenticated{}, errs.WithOp(op, err, "failed to record api token use")
+		}
+	}
+
+	return Authenticated{TokenID: token.ID(), UserID: token.UserID(), Scope: token.Scope()}, nil
+}
+
+func invalidToken(op errs.Op, err error) *errs.Error {
+	return errs.
+		NewAuthorizationError(op, err, "invalid api token").
+		WithMessages([]errs.Message{{Key: "message", Value: "invalid or revoked api token"}})
+}
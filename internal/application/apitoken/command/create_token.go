@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type CreateToken struct {
+	ID     uuid.UUID      `json:"id"`
+	UserID uuid.UUID      `json:"user_id"`
+	Name   string         `json:"name"`
+	Scope  apitoken.Scope `json:"scope"`
+}
+
+type CreateTokenHandler struct {
+	repo apitoken.Repository
+}
+
+func NewCreateTokenHandler(repo apitoken.Repository) CreateTokenHandler {
+	return CreateTokenHandler{repo: repo}
+}
+
+// Handle creates the api token and returns the token to show to the user, it is not kept.
+func (h *CreateTokenHandler) Handle(ctx context.Context, cmd CreateToken) (string, error) {
+	op := errs.Op("application.apitoken.command.create_token")
+	token, secret, err := apitoken.NewToken(apitoken.NewTokenArgs{
+		ID:     cmd.ID,
+		UserID: cmd.UserID,
+		Name:   cmd.Name,
+		Scope:  cmd.Scope,
+	})
+	if err != nil {
+		return "", errs.WithOp(op, err, "failed to create new api token")
+	}
+
+	if err := h.repo.Save(ctx, *token); err != nil {
+		return "", errs.WithOp(op, err, "failed to save new api token")
+	}
+
+	return secret, nil
+}
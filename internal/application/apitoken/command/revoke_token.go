@@ -0,0 +1,52 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// RevokeToken revokes an api token of the user, the requests with it are rejected afterwards.
+type RevokeToken struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type RevokeTokenHandler struct {
+	repo apitoken.Repository
+}
+
+func NewRevokeTokenHandler(repo apitoken.Repository) RevokeTokenHandler {
+	return RevokeTokenHandler{repo: repo}
+}
+
+func (h *RevokeTokenHandler) Handle(ctx context.Context, cmd RevokeToken) error {
+	op := errs.Op("application.apitoken.command.revoke_token")
+	if cmd.ID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "token id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "token id must be provided"}})
+	}
+
+	err := h.repo.Update(ctx, cmd.ID, func(token *apitoken.Token) (*apitoken.Token, error) {
+		if !token.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to revoke the api token").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to revoke the api token"}}).
+				WithContext("token_id", cmd.ID).
+				WithContext("user_id", cmd.UserID)
+		}
+		if err := token.Revoke(time.Now()); err != nil {
+			return nil, errs.WithOp(op, err, "failed to revoke api token")
+		}
+		return token, nil
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to update api token")
+	}
+	return nil
+}
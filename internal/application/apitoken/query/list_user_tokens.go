@@ -0,0 +1,41 @@
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type ListUserTokensReadModel interface {
+	// ListUserAPITokens returns the active api tokens of the user, the newest first.
+	ListUserAPITokens(ctx context.Context, userID uuid.UUID) ([]APIToken, error)
+}
+
+type ListUserTokens struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListUserTokensHandler struct {
+	readModel ListUserTokensReadModel
+}
+
+func NewListUserTokensHandler(readModel ListUserTokensReadModel) ListUserTokensHandler {
+	return ListUserTokensHandler{readModel: readModel}
+}
+
+func (h ListUserTokensHandler) Handle(ctx context.Context, query ListUserTokens) ([]APIToken, error) {
+	op := errs.Op("application.apitoken.query.list_user_tokens")
+	if query.UserID.IsNil() {
+		return nil, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}})
+	}
+
+	tokens, err := h.readModel.ListUserAPITokens(ctx, query.UserID)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to list user api tokens")
+	}
+	return tokens, nil
+}
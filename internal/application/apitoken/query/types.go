@@ -0,0 +1,18 @@
+package query
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+// APIToken is an active api token, the token itself is never shown again.
+type APIToken struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	Scope     string    `json:"scope"`
+	CreatedAt time.Time `json:"created_at"`
+	// LastUsedAt is nil if the token was never used.
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
@@ -1,13 +1,17 @@
 package application
 
 import (
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile"
 	"github.com/ARUMANDESU/go-revise/internal/application/notification"
 	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem"
 	"github.com/ARUMANDESU/go-revise/internal/application/user"
 )
 
 type Application struct {
-	User         user.Application
-	ReviseItem   reviseitem.Application
-	Notification notification.Application
+	User            user.Application
+	ReviseItem      reviseitem.Application
+	IntervalProfile intervalprofile.Application
+	Notification    notification.Application
+	APIToken        apitoken.Application
 }
@@ -0,0 +1,23 @@
+package intervalprofile
+
+import (
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/query"
+)
+
+type Application struct {
+	Commands Commands
+	Queries  Queries
+}
+
+type Commands struct {
+	CreateProfile command.CreateProfileHandler
+	ChangeProfile command.ChangeProfileHandler
+	DeleteProfile command.DeleteProfileHandler
+	AttachToTag   command.AttachToTagHandler
+	DetachFromTag command.DetachFromTagHandler
+}
+
+type Queries struct {
+	ListUserIntervalProfiles query.ListUserIntervalProfilesHandler
+}
@@ -0,0 +1,92 @@
+package command
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// AttachToTag attaches the interval profile to the user's tag,
+// so that the user's items with the tag are scheduled with the profile.
+type AttachToTag struct {
+	ProfileID uuid.UUID `json:"profile_id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Tag       string    `json:"tag"`
+}
+
+type AttachToTagHandler struct {
+	repo     intervalprofile.Repository
+	profiles ProfileProvider
+}
+
+func NewAttachToTagHandler(
+	repo intervalprofile.Repository,
+	profiles ProfileProvider,
+) AttachToTagHandler {
+	return AttachToTagHandler{repo: repo, profiles: profiles}
+}
+
+func (h *AttachToTagHandler) Handle(ctx context.Context, cmd AttachToTag) error {
+	op := errs.Op("application.intervalprofile.command.attach_to_tag")
+	cmd.Tag = strings.TrimSpace(cmd.Tag)
+	if err := validateTag(op, cmd.Tag); err != nil {
+		return err
+	}
+	if err := checkProfileOwner(ctx, op, h.profiles, cmd.ProfileID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := h.repo.AttachToTag(ctx, cmd.UserID, cmd.Tag, cmd.ProfileID); err != nil {
+		return errs.WithOp(op, err, "failed to attach interval profile to tag")
+	}
+	return nil
+}
+
+// DetachFromTag detaches the interval profile from the user's tag.
+type DetachFromTag struct {
+	UserID uuid.UUID `json:"user_id"`
+	Tag    string    `json:"tag"`
+}
+
+type DetachFromTagHandler struct {
+	repo intervalprofile.Repository
+}
+
+func NewDetachFromTagHandler(repo intervalprofile.Repository) DetachFromTagHandler {
+	return DetachFromTagHandler{repo: repo}
+}
+
+func (h *DetachFromTagHandler) Handle(ctx context.Context, cmd DetachFromTag) error {
+	op := errs.Op("application.intervalprofile.command.detach_from_tag")
+	cmd.Tag = strings.TrimSpace(cmd.Tag)
+	if err := validateTag(op, cmd.Tag); err != nil {
+		return err
+	}
+	if cmd.UserID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}})
+	}
+
+	if err := h.repo.DetachFromTag(ctx, cmd.UserID, cmd.Tag); err != nil {
+		return errs.WithOp(op, err, "failed to detach interval profile from tag")
+	}
+	return nil
+}
+
+func validateTag(op errs.Op, tag string) error {
+	if tag == "" {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "tag must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "tag must be provided"}})
+	}
+	if err := valueobject.ValidateTags(valueobject.NewTags(tag)); err != nil {
+		return errs.WithOp(op, err, "invalid tag")
+	}
+	return nil
+}
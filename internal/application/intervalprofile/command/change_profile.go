@@ -0,0 +1,62 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ChangeProfile changes the name and/or the intervals of an interval profile.
+// Only the provided (non-nil) fields are changed.
+type ChangeProfile struct {
+	ID        uuid.UUID                   `json:"id"`
+	UserID    uuid.UUID                   `json:"user_id"`
+	Name      *string                     `json:"name"`
+	Intervals *valueobject.ReviewInterval `json:"intervals"`
+}
+
+type ChangeProfileHandler struct {
+	repo intervalprofile.Repository
+}
+
+func NewChangeProfileHandler(repo intervalprofile.Repository) ChangeProfileHandler {
+	return ChangeProfileHandler{repo: repo}
+}
+
+func (h *ChangeProfileHandler) Handle(ctx context.Context, cmd ChangeProfile) error {
+	op := errs.Op("application.intervalprofile.command.change_profile")
+	if cmd.ID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+
+	err := h.repo.Update(ctx, cmd.ID, func(p *intervalprofile.Profile) (*intervalprofile.Profile, error) {
+		if !p.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to modify the interval profile").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the interval profile"}}).
+				WithContext("cmd", cmd)
+		}
+		if cmd.Name != nil {
+			if err := p.Rename(*cmd.Name); err != nil {
+				return nil, errs.WithOp(op, err, "failed to rename interval profile")
+			}
+		}
+		if cmd.Intervals != nil {
+			if err := p.ChangeIntervals(*cmd.Intervals); err != nil {
+				return nil, errs.WithOp(op, err, "failed to change interval profile intervals")
+			}
+		}
+		return p, nil
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to update interval profile")
+	}
+	return nil
+}
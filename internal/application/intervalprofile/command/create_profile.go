@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type CreateProfile struct {
+	ID        uuid.UUID                  `json:"id"`
+	UserID    uuid.UUID                  `json:"user_id"`
+	Name      string                     `json:"name"`
+	Intervals valueobject.ReviewInterval `json:"intervals"`
+}
+
+type CreateProfileHandler struct {
+	repo intervalprofile.Repository
+}
+
+func NewCreateProfileHandler(repo intervalprofile.Repository) CreateProfileHandler {
+	return CreateProfileHandler{repo: repo}
+}
+
+func (h *CreateProfileHandler) Handle(ctx context.Context, cmd CreateProfile) error {
+	op := errs.Op("application.intervalprofile.command.create_profile")
+	profile, err := intervalprofile.NewProfile(intervalprofile.NewProfileArgs{
+		ID:        cmd.ID,
+		UserID:    cmd.UserID,
+		Name:      cmd.Name,
+		Intervals: cmd.Intervals,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create new interval profile")
+	}
+
+	if err := h.repo.Save(ctx, *profile); err != nil {
+		return errs.WithOp(op, err, "failed to save new interval profile")
+	}
+
+	return nil
+}
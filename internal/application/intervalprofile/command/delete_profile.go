@@ -0,0 +1,72 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ProfileProvider provides interval profiles to check their owner.
+type ProfileProvider interface {
+	GetIntervalProfile(ctx context.Context, id uuid.UUID) (*intervalprofile.Profile, error)
+}
+
+// DeleteProfile deletes an interval profile, the items and tags it was attached to fall back to the next source.
+type DeleteProfile struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type DeleteProfileHandler struct {
+	repo     intervalprofile.Repository
+	profiles ProfileProvider
+}
+
+func NewDeleteProfileHandler(
+	repo intervalprofile.Repository,
+	profiles ProfileProvider,
+) DeleteProfileHandler {
+	return DeleteProfileHandler{repo: repo, profiles: profiles}
+}
+
+func (h *DeleteProfileHandler) Handle(ctx context.Context, cmd DeleteProfile) error {
+	op := errs.Op("application.intervalprofile.command.delete_profile")
+	if err := checkProfileOwner(ctx, op, h.profiles, cmd.ID, cmd.UserID); err != nil {
+		return err
+	}
+
+	if err := h.repo.Delete(ctx, cmd.ID); err != nil {
+		return errs.WithOp(op, err, "failed to delete interval profile")
+	}
+	return nil
+}
+
+// checkProfileOwner returns an error if the profile does not exist or belongs to another user.
+func checkProfileOwner(
+	ctx context.Context,
+	op errs.Op,
+	profiles ProfileProvider,
+	profileID, userID uuid.UUID,
+) error {
+	if profileID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "profile id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "profile id must be provided"}})
+	}
+
+	profile, err := profiles.GetIntervalProfile(ctx, profileID)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get interval profile")
+	}
+	if !profile.CanModify(userID) {
+		return errs.
+			NewForbiddenError(op, nil, "user is not allowed to modify the interval profile").
+			WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the interval profile"}}).
+			WithContext("profile_id", profileID).
+			WithContext("user_id", userID)
+	}
+	return nil
+}
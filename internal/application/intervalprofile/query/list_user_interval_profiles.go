@@ -0,0 +1,45 @@
+package query
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type ListUserIntervalProfilesReadModel interface {
+	ListUserIntervalProfiles(ctx context.Context, userID uuid.UUID) ([]IntervalProfile, error)
+}
+
+type ListUserIntervalProfiles struct {
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ListUserIntervalProfilesHandler struct {
+	readModel ListUserIntervalProfilesReadModel
+}
+
+func NewListUserIntervalProfilesHandler(
+	readModel ListUserIntervalProfilesReadModel,
+) ListUserIntervalProfilesHandler {
+	return ListUserIntervalProfilesHandler{readModel: readModel}
+}
+
+func (h ListUserIntervalProfilesHandler) Handle(
+	ctx context.Context,
+	query ListUserIntervalProfiles,
+) ([]IntervalProfile, error) {
+	op := errs.Op("application.intervalprofile.query.list_user_interval_profiles")
+	if query.UserID.IsNil() {
+		return nil, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}})
+	}
+
+	profiles, err := h.readModel.ListUserIntervalProfiles(ctx, query.UserID)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to list user interval profiles")
+	}
+	return profiles, nil
+}
@@ -0,0 +1,18 @@
+package query
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type IntervalProfile struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	Intervals string    `json:"intervals"`
+	// Tags the profile is attached to.
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
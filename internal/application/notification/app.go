@@ -2,79 +2,266 @@ package notification
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/gofrs/uuid"
 
+	"github.com/ARUMANDESU/go-revise/internal/domain/outbox"
 	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
 	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
-	"github.com/ARUMANDESU/go-revise/pkg/retry"
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
 )
 
+// DefaultCatchUp is how far back missed reminders are still sent, e.g. after a downtime.
+const DefaultCatchUp = 6 * time.Hour
+
+// deliveryBatchSize limits the deliveries attempted per tick, the rest are attempted on the next ones.
+const deliveryBatchSize = 100
+
 // UserProvider defines the methods for user data access.
 type UserProvider interface {
-	// GetUsersForNotification selects users whose notify time is less than now and greater than now - 1 minute.
+	// GetUsersForNotification selects users with reminder slots along with the time they were last notified at.
 	GetUsersForNotification(ctx context.Context) ([]domainUser.User, error)
+	// GetUser returns the user by id.
+	GetUser(ctx context.Context, id uuid.UUID) (*domainUser.User, error)
+}
+
+// Outbox stores the notifications to be delivered, one per user, reminder slot and channel.
+type Outbox interface {
+	// Enqueue adds the deliveries of a reminder, one per channel, and moves the last notified at watermark
+	// of the user to the reminder.
+	// It returns false if the user was already notified for this or a later reminder.
+	Enqueue(ctx context.Context, deliveries []outbox.Delivery) (bool, error)
+	// ListPending returns up to limit pending deliveries due for an attempt at the given time.
+	ListPending(ctx context.Context, now time.Time, limit int) ([]outbox.Delivery, error)
+	// Update updates the state of the delivery after an attempt.
+	Update(ctx context.Context, delivery outbox.Delivery) error
 }
 
 type ReviseItemProvider interface {
+	// FetchReviseItemsDueForUser returns the items of the user due before the given time.
 	FetchReviseItemsDueForUser(
 		ctx context.Context,
 		userID uuid.UUID,
+		until time.Time,
 	) ([]reviseitem.ReviseItem, error)
+	// GetUserDailyUsage returns how many items the user went through since the given time.
+	GetUserDailyUsage(ctx context.Context, userID uuid.UUID, since time.Time) (valueobject.DailyUsage, error)
 }
 
+// Notifier sends the reminder to a channel of the user. The outbox deliveries are at least once,
+// so the same reminder may be sent again after a crash between the send and its outbox update.
 type Notifier interface {
 	Notify(ctx context.Context, user domainUser.User, reviseItems []reviseitem.ReviseItem) error
 }
 
+// Notifiers are the notifiers of the channels, a channel without a notifier is not used.
+type Notifiers map[domainUser.Channel]Notifier
+
 type Application struct {
 	UserProvider       UserProvider
 	ReviseItemProvider ReviseItemProvider
-	Notifier           Notifier
+	Outbox             Outbox
+	Notifiers          Notifiers
+	// CatchUp is how far back missed reminders are still sent, DefaultCatchUp if zero.
+	CatchUp time.Duration
 }
 
 func NewApplication(
 	userProvider UserProvider,
 	reviseItemProvider ReviseItemProvider,
-	notifier Notifier,
+	outbox Outbox,
+	notifiers Notifiers,
 ) Application {
 	return Application{
 		UserProvider:       userProvider,
 		ReviseItemProvider: reviseItemProvider,
-		Notifier:           notifier,
+		Outbox:             outbox,
+		Notifiers:          notifiers,
+		CatchUp:            DefaultCatchUp,
 	}
 }
 
-func (a Application) NotifyUsers(ctx context.Context) error {
+// NotifyUsers enqueues the reminders due at the given time and delivers the pending ones.
+func (a Application) NotifyUsers(ctx context.Context, now time.Time) error {
 	op := errs.Op("application.notification.notify_users")
+
+	enqueueErr := a.EnqueueReminders(ctx, now)
+	if enqueueErr != nil {
+		enqueueErr = errs.WithOp(op, enqueueErr, "failed to enqueue reminders")
+	}
+	// the pending deliveries are attempted even if some reminders could not be enqueued
+	deliverErr := a.DeliverPending(ctx, now)
+	if deliverErr != nil {
+		deliverErr = errs.WithOp(op, deliverErr, "failed to deliver pending notifications")
+	}
+	return errors.Join(enqueueErr, deliverErr)
+}
+
+// EnqueueReminders adds a delivery per channel to the outbox for every user who has a reminder due
+// at the given time, including the ones missed within the catch up window.
+// A reminder is enqueued at most once, even if the ticks overlap.
+func (a Application) EnqueueReminders(ctx context.Context, now time.Time) error {
+	op := errs.Op("application.notification.enqueue_reminders")
 	users, err := a.UserProvider.GetUsersForNotification(ctx)
 	if err != nil {
 		return errs.WithOp(op, err, "failed to get users for notification")
 	}
 
+	catchUp := a.CatchUp
+	if catchUp <= 0 {
+		catchUp = DefaultCatchUp
+	}
+
+	var enqueueErrs []error
 	for _, user := range users {
-		slog.Debug("Notifying User", slog.Int64("user", int64(user.ChatID())))
-		err = retry.Do(func() error {
-			reviseItems, err := a.ReviseItemProvider.FetchReviseItemsDueForUser(ctx, user.ID())
-			if err != nil {
-				return errs.WithOp(op, err, "failed to fetch revise items for user")
-			}
-
-			err = a.Notifier.Notify(ctx, user, reviseItems)
-			if err != nil {
-				return errs.WithOp(op, err, fmt.Sprintf("failed to notify user %s", user.ID()))
-			}
-			return nil
-		}, retry.WithMaxRetries(6))
+		at, due := user.DueReminder(now, catchUp)
+		if !due {
+			continue
+		}
+
+		deliveries := a.newDeliveries(user, at, now)
+		if len(deliveries) == 0 {
+			slog.Warn("no notifier for the channels of the user",
+				slog.String("user", user.ID().String()),
+				slog.Any("channels", user.Settings().Channels))
+			continue
+		}
+
+		enqueued, err := a.Outbox.Enqueue(ctx, deliveries)
 		if err != nil {
-			return errs.WithOp(op, err, "failed to notify user")
+			enqueueErrs = append(enqueueErrs, errs.WithOp(op, err, fmt.Sprintf("failed to enqueue reminder of user %s", user.ID())))
+			continue
+		}
+		if enqueued {
+			slog.Debug("enqueued reminder",
+				slog.Int64("user", int64(user.ChatID())),
+				slog.Time("reminder", at))
 		}
 	}
+	return errors.Join(enqueueErrs...)
+}
 
-	slog.Debug("Notified all users")
+// newDeliveries returns the deliveries of the reminder through the user's channels which have a notifier.
+func (a Application) newDeliveries(user domainUser.User, reminderAt, now time.Time) []outbox.Delivery {
+	channels := user.Settings().Channels
+	deliveries := make([]outbox.Delivery, 0, len(channels))
+	for _, channel := range channels {
+		if _, ok := a.Notifiers[channel]; !ok {
+			continue
+		}
+		deliveries = append(deliveries, outbox.NewDelivery(user.ID(), channel, reminderAt, now))
+	}
+	return deliveries
+}
+
+// DeliverPending attempts the pending deliveries due at the given time.
+// A failed delivery is retried later with a backoff and does not stop the others.
+func (a Application) DeliverPending(ctx context.Context, now time.Time) error {
+	op := errs.Op("application.notification.deliver_pending")
+	deliveries, err := a.Outbox.ListPending(ctx, now, deliveryBatchSize)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to list pending deliveries")
+	}
+
+	for _, delivery := range deliveries {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		sent, err := a.deliver(ctx, delivery, now)
+		switch {
+		case err != nil:
+			delivery.MarkFailed(err, now)
+			slog.Warn("failed to deliver notification",
+				slog.String("delivery", delivery.ID().String()),
+				slog.String("user", delivery.UserID().String()),
+				slog.String("channel", string(delivery.Channel())),
+				slog.Int("attempts", delivery.Attempts()),
+				slog.String("status", string(delivery.Status())),
+				logutil.Err(err))
+		case sent:
+			delivery.MarkSent(now)
+		default:
+			delivery.MarkSkipped(now)
+		}
+
+		if err := a.Outbox.Update(ctx, delivery); err != nil {
+			return errs.WithOp(op, err, "failed to update delivery")
+		}
+	}
+
+	slog.Debug("delivered pending notifications", slog.Int("count", len(deliveries)))
 
 	return nil
 }
+
+// deliver notifies the user of the delivery about the due revise items through the channel of the delivery,
+// it returns false if there was nothing to notify about.
+func (a Application) deliver(ctx context.Context, delivery outbox.Delivery, now time.Time) (bool, error) {
+	op := errs.Op("application.notification.deliver")
+	notifier, ok := a.Notifiers[delivery.Channel()]
+	if !ok {
+		return false, errs.
+			NewNotFound(op, nil, "no notifier for the channel").
+			WithContext("channel", delivery.Channel())
+	}
+
+	user, err := a.UserProvider.GetUser(ctx, delivery.UserID())
+	if err != nil {
+		return false, errs.WithOp(op, err, "failed to get user")
+	}
+
+	reviseItems, err := a.dueReviseItems(ctx, *user, now)
+	if err != nil {
+		return false, errs.WithOp(op, err, "failed to get due revise items for user")
+	}
+	if len(reviseItems) == 0 {
+		return false, nil
+	}
+
+	if err := notifier.Notify(ctx, *user, reviseItems); err != nil {
+		return false, errs.WithOp(op, err, "failed to notify user")
+	}
+	return true, nil
+}
+
+// dueReviseItems returns the revise items due for the user today within the daily quota,
+// the rest is carried over to the next day.
+func (a Application) dueReviseItems(
+	ctx context.Context,
+	user domainUser.User,
+	now time.Time,
+) ([]reviseitem.ReviseItem, error) {
+	op := errs.Op("application.notification.due_revise_items")
+	// the day is the local day of the user
+	dayStart, dayEnd := user.Settings().Timezone.DayBounds(now)
+	reviseItems, err := a.ReviseItemProvider.FetchReviseItemsDueForUser(ctx, user.ID(), dayEnd)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to fetch revise items for user")
+	}
+
+	usage, err := a.ReviseItemProvider.GetUserDailyUsage(ctx, user.ID(), dayStart)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to get user daily usage")
+	}
+
+	selected, carriedOver := valueobject.SelectWithinQuota(
+		reviseItems,
+		user.Settings().DailyQuota,
+		usage,
+		func(item reviseitem.ReviseItem) time.Time { return item.NextRevisionAt() },
+		func(item reviseitem.ReviseItem) bool { return item.LastRevisedAt().IsZero() },
+	)
+	if carriedOver > 0 {
+		slog.Debug("carried over revise items",
+			slog.String("user", user.ID().String()),
+			slog.Int("count", carriedOver))
+	}
+	return selected, nil
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
+)
+
+// DefaultInterval is how often the scheduler checks for due reminders.
+const DefaultInterval = time.Minute
+
+// Scheduler notifies the users on every tick until the context is done.
+type Scheduler struct {
+	app      Application
+	interval time.Duration
+	now      func() time.Time
+}
+
+func NewScheduler(app Application, interval time.Duration) Scheduler {
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	return Scheduler{
+		app:      app,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Run notifies the users right away, to catch up on the reminders missed while the app was down,
+// and then on every tick until the context is done.
+// A slow tick delays the next one instead of overlapping it, the missed reminders are caught up then.
+func (s Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.tick(ctx)
+
+		select {
+		case <-ctx.Done():
+			slog.Debug("notification scheduler stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s Scheduler) tick(ctx context.Context) {
+	slog.Debug("notifying users")
+	err := s.app.NotifyUsers(ctx, s.now())
+	if err != nil && ctx.Err() == nil {
+		slog.Error("failed to notify users", logutil.Err(err))
+	}
+}
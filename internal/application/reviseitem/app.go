@@ -11,16 +11,24 @@ type Application struct {
 }
 
 type Query struct {
-	GetReviseItem       query.GetReviseItemHandler
-	ListUserReviseItems query.ListUserReviseItemsHandler
+	GetReviseItem         query.GetReviseItemHandler
+	ListUserReviseItems   query.ListUserReviseItemsHandler
+	ListDueReviseItems    query.ListDueReviseItemsHandler
+	ListUserTags          query.ListUserTagsHandler
+	SearchUserReviseItems query.SearchUserReviseItemsHandler
 }
 
 type Command struct {
-	NewReviseItem     command.NewReviseItemHandler
-	DeleteReviseItem  command.DeleteReviseItemHandler
-	ChangeDescription command.ChangeDescriptionHandler
-	ChangeName        command.ChangeNameHandler
-	AddTags           command.AddTagsHandler
-	RemoveTags        command.RemoveTagsHandler
-	Review            command.ReviewHandler
+	NewReviseItem      command.NewReviseItemHandler
+	DeleteReviseItem   command.DeleteReviseItemHandler
+	RestoreReviseItem  command.RestoreReviseItemHandler
+	ChangeDescription  command.ChangeDescriptionHandler
+	ChangeName         command.ChangeNameHandler
+	AddTags            command.AddTagsHandler
+	RemoveTags         command.RemoveTagsHandler
+	Review             command.ReviewHandler
+	Postpone           command.PostponeHandler
+	ResetProgress      command.ResetProgressHandler
+	SetIntervalProfile command.SetIntervalProfileHandler
+	ChangeReviseItem   command.ChangeReviseItemHandler
 }
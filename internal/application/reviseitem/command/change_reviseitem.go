@@ -0,0 +1,77 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ChangeReviseItem changes several fields of the revise item at once, only the provided (non-nil) ones.
+// Either all of them are changed or none, an invalid field leaves the item as it was.
+type ChangeReviseItem struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	Name        *string   `json:"name"`
+	Description *string   `json:"description"`
+	// IntervalProfileID attaches the interval profile, uuid.Nil detaches it.
+	IntervalProfileID *uuid.UUID `json:"interval_profile_id"`
+}
+
+type ChangeReviseItemHandler struct {
+	repo     reviseitem.Repository
+	profiles IntervalProfileProvider
+}
+
+func NewChangeReviseItemHandler(
+	repo reviseitem.Repository,
+	profiles IntervalProfileProvider,
+) ChangeReviseItemHandler {
+	return ChangeReviseItemHandler{repo: repo, profiles: profiles}
+}
+
+func (h *ChangeReviseItemHandler) Handle(ctx context.Context, cmd ChangeReviseItem) error {
+	op := errs.Op("application.reviseitem.command.change_revise_item")
+	if cmd.ID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+	if cmd.IntervalProfileID != nil && !cmd.IntervalProfileID.IsNil() {
+		if err := checkIntervalProfileOwner(ctx, op, h.profiles, *cmd.IntervalProfileID, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	err := h.repo.Update(ctx, cmd.ID, func(item *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
+		if !item.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to modify the item").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
+				WithContext("cmd", cmd)
+		}
+
+		if cmd.Name != nil {
+			if err := item.UpdateName(*cmd.Name); err != nil {
+				return nil, errs.WithOp(op, err, "failed to change name of revise item")
+			}
+		}
+		if cmd.Description != nil {
+			if err := item.UpdateDescription(*cmd.Description); err != nil {
+				return nil, errs.WithOp(op, err, "failed to change description of revise item")
+			}
+		}
+		if cmd.IntervalProfileID != nil {
+			item.SetIntervalProfile(*cmd.IntervalProfileID)
+		}
+
+		return item, nil
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to update revise item")
+	}
+	return nil
+}
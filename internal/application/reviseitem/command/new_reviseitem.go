@@ -2,12 +2,15 @@ package command
 
 import (
 	"context"
+	"log/slog"
+	"strings"
 
 	"github.com/gofrs/uuid"
 
 	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
 	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
 )
 
 type NewReviseItem struct {
@@ -16,29 +19,77 @@ type NewReviseItem struct {
 	Name        string           `json:"name"`
 	Description string           `json:"description,omitempty"`
 	Tags        valueobject.Tags `json:"tags,omitempty"`
+	// Source is the URL of the source of the item, optional.
+	// The name defaults to the title of the page at the source.
+	Source string `json:"source,omitempty"`
+	// IntervalProfileID is the interval profile to attach to the item, optional.
+	IntervalProfileID uuid.UUID `json:"interval_profile_id,omitempty"`
 }
 
-func (n NewReviseItem) toArgs() reviseitem.NewReviseItemArgs {
+func (n NewReviseItem) toArgs(intervals valueobject.ReviewInterval) reviseitem.NewReviseItemArgs {
 	return reviseitem.NewReviseItemArgs{
-		ID:          n.ID,
-		UserID:      n.UserID,
-		Name:        n.Name,
-		Description: n.Description,
-		Tags:        n.Tags,
+		ID:                n.ID,
+		UserID:            n.UserID,
+		Name:              n.Name,
+		Description:       n.Description,
+		Tags:              n.Tags,
+		Source:            n.Source,
+		IntervalProfileID: n.IntervalProfileID,
+		ReviewIntervals:   intervals,
 	}
 }
 
+// TitleFetcher fetches the title of the web page at the URL.
+type TitleFetcher interface {
+	Title(ctx context.Context, url string) (string, error)
+}
+
 type NewReviseItemHandler struct {
-	repo reviseitem.Repository
+	repo     reviseitem.Repository
+	resolver ReviewIntervalsResolver
+	profiles IntervalProfileProvider
+	titles   TitleFetcher
 }
 
-func NewNewReviseItemHandler(repo reviseitem.Repository) NewReviseItemHandler {
-	return NewReviseItemHandler{repo: repo}
+// NewNewReviseItemHandler creates the handler, titles is optional,
+// without it the items created without a name are named after their source.
+func NewNewReviseItemHandler(
+	repo reviseitem.Repository,
+	resolver ReviewIntervalsResolver,
+	profiles IntervalProfileProvider,
+	titles TitleFetcher,
+) NewReviseItemHandler {
+	return NewReviseItemHandler{repo: repo, resolver: resolver, profiles: profiles, titles: titles}
 }
 
 func (h *NewReviseItemHandler) Handle(ctx context.Context, cmd NewReviseItem) error {
 	op := errs.Op("application.reviseitem.command.new_reviseitem")
-	item, err := reviseitem.NewReviseItem(cmd.toArgs())
+	if cmd.UserID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+
+	if !cmd.IntervalProfileID.IsNil() {
+		if err := checkIntervalProfileOwner(ctx, op, h.profiles, cmd.IntervalProfileID, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	if strings.TrimSpace(cmd.Name) == "" && cmd.Source != "" {
+		if err := reviseitem.ValidateSource(cmd.Source); err != nil {
+			return errs.WithOp(op, err, "invalid source")
+		}
+		cmd.Name = h.sourceName(ctx, cmd.Source)
+	}
+
+	intervals, err := h.resolver.ResolveReviewIntervals(ctx, cmd.UserID, cmd.IntervalProfileID, cmd.Tags)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to resolve review intervals")
+	}
+
+	item, err := reviseitem.NewReviseItem(cmd.toArgs(intervals))
 	if err != nil {
 		return errs.WithOp(op, err, "failed to create new revise item")
 	}
@@ -49,3 +100,19 @@ func (h *NewReviseItemHandler) Handle(ctx context.Context, cmd NewReviseItem) er
 
 	return nil
 }
+
+// sourceName returns the title of the page at the source, or the source itself if the title is unavailable.
+func (h *NewReviseItemHandler) sourceName(ctx context.Context, source string) string {
+	name := source
+	if h.titles != nil {
+		title, err := h.titles.Title(ctx, source)
+		if err != nil {
+			slog.Warn("failed to fetch the title of the source",
+				slog.String("source", source),
+				logutil.Err(err))
+		} else if title != "" {
+			name = title
+		}
+	}
+	return reviseitem.TruncateName(name)
+}
@@ -0,0 +1,82 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Postpone moves the next revision of a revise item without counting as a revision.
+// Exactly one of Duration and Until must be provided.
+type Postpone struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+	// Duration shifts the due date, an overdue item is shifted from now.
+	Duration time.Duration `json:"duration"`
+	// Until is the new due date.
+	Until time.Time `json:"until"`
+}
+
+type PostponeHandler struct {
+	repo reviseitem.Repository
+}
+
+func NewPostponeHandler(repo reviseitem.Repository) PostponeHandler {
+	return PostponeHandler{repo: repo}
+}
+
+// Handle postpones the revise item and returns the time of its next revision.
+func (h *PostponeHandler) Handle(ctx context.Context, cmd Postpone) (time.Time, error) {
+	op := errs.Op("application.reviseitem.command.postpone")
+	if cmd.ID.IsNil() {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+	if (cmd.Duration == 0) == cmd.Until.IsZero() {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "either duration or until must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "either duration or until must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+	if cmd.Duration < 0 {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "duration must be positive").
+			WithMessages([]errs.Message{{Key: "message", Value: "duration must be positive"}}).
+			WithContext("cmd", cmd)
+	}
+
+	var nextRevisionAt time.Time
+	err := h.repo.Update(ctx, cmd.ID, func(item *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
+		if !item.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to modify the item").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
+				WithContext("cmd", cmd)
+		}
+
+		nextRevisionAt = cmd.Until
+		if cmd.Duration > 0 {
+			from := item.NextRevisionAt()
+			if now := time.Now(); from.Before(now) {
+				from = now
+			}
+			nextRevisionAt = from.Add(cmd.Duration)
+		}
+
+		if err := item.Postpone(nextRevisionAt); err != nil {
+			return nil, errs.WithOp(op, err, "failed to postpone revise item")
+		}
+
+		return item, nil
+	})
+	if err != nil {
+		return time.Time{}, errs.WithOp(op, err, "failed to update revise item")
+	}
+	return nextRevisionAt, nil
+}
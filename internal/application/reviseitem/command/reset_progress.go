@@ -0,0 +1,63 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ResetProgress restarts the progress of a revise item, the revision history is kept.
+type ResetProgress struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type ResetProgressHandler struct {
+	repo     reviseitem.Repository
+	resolver ReviewIntervalsResolver
+}
+
+func NewResetProgressHandler(repo reviseitem.Repository, resolver ReviewIntervalsResolver) ResetProgressHandler {
+	return ResetProgressHandler{repo: repo, resolver: resolver}
+}
+
+// Handle resets the progress of the revise item and returns the time of its next revision.
+func (h *ResetProgressHandler) Handle(ctx context.Context, cmd ResetProgress) (time.Time, error) {
+	op := errs.Op("application.reviseitem.command.reset_progress")
+	if cmd.ID.IsNil() {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+
+	var nextRevisionAt time.Time
+	err := h.repo.Update(ctx, cmd.ID, func(item *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
+		if !item.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to modify the item").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
+				WithContext("cmd", cmd)
+		}
+
+		intervals, err := h.resolver.ResolveReviewIntervals(ctx, item.UserID(), item.IntervalProfileID(), item.Tags())
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to resolve review intervals")
+		}
+
+		if err := item.ResetProgress(intervals.At(0)); err != nil {
+			return nil, errs.WithOp(op, err, "failed to reset progress of revise item")
+		}
+		nextRevisionAt = item.NextRevisionAt()
+
+		return item, nil
+	})
+	if err != nil {
+		return time.Time{}, errs.WithOp(op, err, "failed to update revise item")
+	}
+	return nextRevisionAt, nil
+}
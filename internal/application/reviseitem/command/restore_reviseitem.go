@@ -0,0 +1,45 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// RestoreReviseItem restores a deleted revise item, it undoes DeleteReviseItem.
+type RestoreReviseItem struct {
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
+}
+
+type RestoreReviseItemHandler struct {
+	repo reviseitem.Repository
+}
+
+func NewRestoreReviseItemHandler(repo reviseitem.Repository) RestoreReviseItemHandler {
+	return RestoreReviseItemHandler{repo: repo}
+}
+
+func (h *RestoreReviseItemHandler) Handle(ctx context.Context, cmd RestoreReviseItem) error {
+	op := errs.Op("application.reviseitem.command.restore_reviseitem")
+	err := h.repo.UpdateDeleted(ctx, cmd.ID, func(item *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
+		if !item.CanModify(cmd.UserID) {
+			return nil, errs.
+				NewForbiddenError(op, nil, "user is not allowed to modify the item").
+				WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
+				WithContext("cmd", cmd)
+		}
+
+		item.Restore()
+
+		return item, nil
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to update revise item")
+	}
+
+	return nil
+}
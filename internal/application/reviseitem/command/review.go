@@ -2,57 +2,166 @@ package command
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid"
 
 	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/revision"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
 type Review struct {
-	ID     uuid.UUID `json:"id"`
-	UserID uuid.UUID `json:"user_id"`
+	ID     uuid.UUID         `json:"id"`
+	UserID uuid.UUID         `json:"user_id"`
+	Grade  valueobject.Grade `json:"grade"`
+	// Relapse is the number of steps a forgotten item is moved back, the grade defaults to again.
+	// Zero means a regular review.
+	Relapse int `json:"relapse"`
+	// Notes, Duration and Confidence are optional journal entries of the revision.
+	Notes      string                 `json:"notes"`
+	Duration   time.Duration          `json:"duration"`
+	Confidence valueobject.Confidence `json:"confidence"`
+}
+
+// ReviewIntervalsResolver resolves the review intervals of a revise item
+// from its interval profile (uuid.Nil if none), its tags and the user settings.
+type ReviewIntervalsResolver interface {
+	ResolveReviewIntervals(
+		ctx context.Context,
+		userID, profileID uuid.UUID,
+		tags valueobject.Tags,
+	) (valueobject.ReviewInterval, error)
+}
+
+// DueCountsProvider provides the number of items of the user due per local day,
+// it is used to spread the due dates across neighbouring days.
+type DueCountsProvider interface {
+	GetUserDueCounts(
+		ctx context.Context,
+		userID uuid.UUID,
+		from time.Time,
+		excludeID uuid.UUID,
+		tz valueobject.Timezone,
+	) (scheduler.DueCounts, error)
+}
+
+// TimezoneProvider provides the time zone of the user, the due dates are balanced across its days.
+type TimezoneProvider interface {
+	GetUserTimezone(ctx context.Context, userID uuid.UUID) (valueobject.Timezone, error)
 }
 
 type ReviewHandler struct {
-	repo reviseitem.Repository
+	repo             reviseitem.Repository
+	resolver         ReviewIntervalsResolver
+	timezoneProvider TimezoneProvider
+	algorithm        scheduler.Algorithm
+	// dueCounts is nil when load balancing is disabled.
+	dueCounts DueCountsProvider
 }
 
-func NewReviewHandler(repo reviseitem.Repository) ReviewHandler {
-	return ReviewHandler{repo: repo}
+// NewReviewHandler returns a review handler, due dates are load balanced only if dueCounts is not nil.
+func NewReviewHandler(
+	repo reviseitem.Repository,
+	resolver ReviewIntervalsResolver,
+	timezoneProvider TimezoneProvider,
+	algorithm scheduler.Algorithm,
+	dueCounts DueCountsProvider,
+) ReviewHandler {
+	return ReviewHandler{
+		repo:             repo,
+		resolver:         resolver,
+		timezoneProvider: timezoneProvider,
+		algorithm:        algorithm,
+		dueCounts:        dueCounts,
+	}
 }
 
-func (h *ReviewHandler) Handle(ctx context.Context, cmd Review) error {
+// Handle reviews the revise item and returns the time of its next revision.
+func (h *ReviewHandler) Handle(ctx context.Context, cmd Review) (time.Time, error) {
 	op := errs.Op("application.reviseitem.command.review")
 	if cmd.ID.IsNil() {
-		return errs.
+		return time.Time{}, errs.
 			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
 			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
 			WithContext("cmd", cmd)
 	}
 	if cmd.UserID.IsNil() {
-		return errs.
+		return time.Time{}, errs.
 			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
 			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}}).
 			WithContext("cmd", cmd)
 	}
+	if cmd.Relapse < 0 {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "relapse must not be negative").
+			WithMessages([]errs.Message{{Key: "message", Value: "relapse must not be negative"}}).
+			WithContext("cmd", cmd)
+	}
+	if !cmd.Grade.IsValid() && (cmd.Relapse == 0 || cmd.Grade != 0) {
+		return time.Time{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "grade must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "grade must be one of: again, hard, good, easy"}}).
+			WithContext("cmd", cmd)
+	}
+
+	var dueCounts scheduler.DueCounts
+	if h.dueCounts != nil {
+		tz, err := h.timezoneProvider.GetUserTimezone(ctx, cmd.UserID)
+		if err != nil {
+			return time.Time{}, errs.WithOp(op, err, "failed to get user timezone")
+		}
+		dueCounts, err = h.dueCounts.GetUserDueCounts(ctx, cmd.UserID, time.Now(), cmd.ID, tz)
+		if err != nil {
+			return time.Time{}, errs.WithOp(op, err, "failed to get user due counts")
+		}
+	}
 
+	var nextRevisionAt time.Time
 	err := h.repo.Update(
 		ctx,
 		cmd.ID,
 		func(ri *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
-			if ri.CanModify(cmd.UserID) {
+			if !ri.CanModify(cmd.UserID) {
 				return nil, errs.
 					NewForbiddenError(op, nil, "user is not allowed to modify the item").
 					WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
 					WithContext("cmd", cmd)
 			}
-			ri.Review()
+			tags := ri.Tags()
+			intervals, err := h.resolver.ResolveReviewIntervals(ctx, ri.UserID(), ri.IntervalProfileID(), tags)
+			if err != nil {
+				return nil, errs.WithOp(op, err, "failed to resolve review intervals")
+			}
+			sched, err := scheduler.New(h.algorithm, intervals)
+			if err != nil {
+				return nil, errs.WithOp(op, err, "failed to create scheduler")
+			}
+			if h.dueCounts != nil {
+				sched = scheduler.NewLoadBalancer(sched, ri.ID(), dueCounts)
+			}
+
+			args := revision.NewRevisionArgs{
+				Grade:      cmd.Grade,
+				Notes:      cmd.Notes,
+				Duration:   cmd.Duration,
+				Confidence: cmd.Confidence,
+			}
+			if cmd.Relapse > 0 {
+				if err := ri.Relapse(sched, cmd.Relapse, args); err != nil {
+					return nil, errs.WithOp(op, err, "failed to relapse revise item")
+				}
+			} else if err := ri.Review(sched, args); err != nil {
+				return nil, errs.WithOp(op, err, "failed to review revise item")
+			}
+			nextRevisionAt = ri.NextRevisionAt()
 			return ri, nil
 		},
 	)
 	if err != nil {
-		return errs.WithOp(op, err, "failed to update revise item")
+		return time.Time{}, errs.WithOp(op, err, "failed to update revise item")
 	}
-	return nil
+	return nextRevisionAt, nil
 }
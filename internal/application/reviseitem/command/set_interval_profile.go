@@ -0,0 +1,90 @@
+package command
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// IntervalProfileProvider provides interval profiles to check their owner.
+type IntervalProfileProvider interface {
+	GetIntervalProfile(ctx context.Context, id uuid.UUID) (*intervalprofile.Profile, error)
+}
+
+// SetIntervalProfile attaches the interval profile to the revise item, uuid.Nil detaches it.
+type SetIntervalProfile struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	ProfileID uuid.UUID `json:"profile_id"`
+}
+
+type SetIntervalProfileHandler struct {
+	repo     reviseitem.Repository
+	profiles IntervalProfileProvider
+}
+
+func NewSetIntervalProfileHandler(
+	repo reviseitem.Repository,
+	profiles IntervalProfileProvider,
+) SetIntervalProfileHandler {
+	return SetIntervalProfileHandler{repo: repo, profiles: profiles}
+}
+
+func (h *SetIntervalProfileHandler) Handle(ctx context.Context, cmd SetIntervalProfile) error {
+	op := errs.Op("application.reviseitem.command.set_interval_profile")
+	if cmd.ID.IsNil() {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "id must be provided"}}).
+			WithContext("cmd", cmd)
+	}
+	if !cmd.ProfileID.IsNil() {
+		if err := checkIntervalProfileOwner(ctx, op, h.profiles, cmd.ProfileID, cmd.UserID); err != nil {
+			return err
+		}
+	}
+
+	err := h.repo.Update(
+		ctx,
+		cmd.ID,
+		func(ri *reviseitem.Aggregate) (*reviseitem.Aggregate, error) {
+			if !ri.CanModify(cmd.UserID) {
+				return nil, errs.
+					NewForbiddenError(op, nil, "user is not allowed to modify the item").
+					WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to modify the item"}}).
+					WithContext("cmd", cmd)
+			}
+			ri.SetIntervalProfile(cmd.ProfileID)
+			return ri, nil
+		},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to update revise item")
+	}
+	return nil
+}
+
+// checkIntervalProfileOwner returns an error if the profile does not exist or belongs to another user.
+func checkIntervalProfileOwner(
+	ctx context.Context,
+	op errs.Op,
+	profiles IntervalProfileProvider,
+	profileID, userID uuid.UUID,
+) error {
+	profile, err := profiles.GetIntervalProfile(ctx, profileID)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get interval profile")
+	}
+	if !profile.CanModify(userID) {
+		return errs.
+			NewForbiddenError(op, nil, "user is not allowed to use the interval profile").
+			WithMessages([]errs.Message{{Key: "message", Value: "user is not allowed to use the interval profile"}}).
+			WithContext("profile_id", profileID).
+			WithContext("user_id", userID)
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package query
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type ListDueReviseItemsReadModel interface {
+	// ListUserDueReviseItems returns the items of the user due until the given time.
+	ListUserDueReviseItems(ctx context.Context, userID uuid.UUID, until time.Time) ([]ReviseItem, error)
+	// GetUserDailyUsage returns how many items the user went through since the given time.
+	GetUserDailyUsage(ctx context.Context, userID uuid.UUID, since time.Time) (valueobject.DailyUsage, error)
+}
+
+type DailyQuotaProvider interface {
+	GetUserDailyQuota(ctx context.Context, userID uuid.UUID) (valueobject.DailyQuota, error)
+}
+
+type TimezoneProvider interface {
+	GetUserTimezone(ctx context.Context, userID uuid.UUID) (valueobject.Timezone, error)
+}
+
+// ListDueReviseItems lists the items the user should revise today within the daily quota.
+type ListDueReviseItems struct {
+	UserID uuid.UUID `json:"user_id"`
+	// DueBy lists only the items due by the time instead of by the end of the local day,
+	// so the items postponed to later today are left out. The zero time means the end of the day.
+	DueBy time.Time `json:"due_by"`
+}
+
+type DueReviseItems struct {
+	// Items are the items to revise today, the most overdue first.
+	Items []ReviseItem `json:"items"`
+	// CarriedOver is the number of due items left for the next days by the daily quota.
+	CarriedOver int `json:"carried_over"`
+}
+
+type ListDueReviseItemsHandler struct {
+	readModel        ListDueReviseItemsReadModel
+	quotaProvider    DailyQuotaProvider
+	timezoneProvider TimezoneProvider
+}
+
+func NewListDueReviseItemsHandler(
+	readModel ListDueReviseItemsReadModel,
+	quotaProvider DailyQuotaProvider,
+	timezoneProvider TimezoneProvider,
+) ListDueReviseItemsHandler {
+	return ListDueReviseItemsHandler{
+		readModel:        readModel,
+		quotaProvider:    quotaProvider,
+		timezoneProvider: timezoneProvider,
+	}
+}
+
+func (h ListDueReviseItemsHandler) Handle(ctx context.Context, query ListDueReviseItems) (DueReviseItems, error) {
+	op := errs.Op("reviseitem.query.list_due_revise_items")
+	if query.UserID.IsNil() {
+		return DueReviseItems{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "user_id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user_id must be provided"}})
+	}
+
+	quota, err := h.quotaProvider.GetUserDailyQuota(ctx, query.UserID)
+	if err != nil {
+		return DueReviseItems{}, errs.WithOp(op, err, "failed to get user daily quota")
+	}
+
+	tz, err := h.timezoneProvider.GetUserTimezone(ctx, query.UserID)
+	if err != nil {
+		return DueReviseItems{}, errs.WithOp(op, err, "failed to get user timezone")
+	}
+
+	// the day is the local day of the user
+	dayStart, dayEnd := tz.DayBounds(time.Now())
+	usage, err := h.readModel.GetUserDailyUsage(ctx, query.UserID, dayStart)
+	if err != nil {
+		return DueReviseItems{}, errs.WithOp(op, err, "failed to get user daily usage")
+	}
+
+	until := dayEnd
+	if !query.DueBy.IsZero() && query.DueBy.Before(dayEnd) {
+		until = query.DueBy
+	}
+	items, err := h.readModel.ListUserDueReviseItems(ctx, query.UserID, until)
+	if err != nil {
+		return DueReviseItems{}, errs.WithOp(op, err, "failed to list user due revise items")
+	}
+
+	selected, carriedOver := valueobject.SelectWithinQuota(
+		items,
+		quota,
+		usage,
+		func(item ReviseItem) time.Time { return item.NextRevisionAt },
+		func(item ReviseItem) bool { return item.LastRevisedAt.IsZero() },
+	)
+	return DueReviseItems{Items: selected, CarriedOver: carriedOver}, nil
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"context"
+	"errors"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type ListUserTagsReadModel interface {
+	// ListUserTags returns the tags of the revise items of the user, the most used first.
+	ListUserTags(ctx context.Context, userID uuid.UUID) ([]string, error)
+}
+
+type ListUserTags struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Limit limits the number of the returned tags, zero for all of them.
+	Limit int `json:"limit"`
+}
+
+type ListUserTagsHandler struct {
+	readModel ListUserTagsReadModel
+}
+
+func NewListUserTagsHandler(readModel ListUserTagsReadModel) ListUserTagsHandler {
+	return ListUserTagsHandler{readModel: readModel}
+}
+
+func (h ListUserTagsHandler) Handle(ctx context.Context, query ListUserTags) ([]string, error) {
+	const op = "reviseitem.query.list_user_tags"
+	if query.UserID.IsNil() {
+		return nil, errs.NewIncorrectInputError(
+			op,
+			errors.New("user_id must not be nil"),
+			"user_id-must-not-be-nil",
+		)
+	}
+
+	tags, err := h.readModel.ListUserTags(ctx, query.UserID)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to list user tags")
+	}
+	if query.Limit > 0 && len(tags) > query.Limit {
+		tags = tags[:query.Limit]
+	}
+	return tags, nil
+}
@@ -0,0 +1,70 @@
+package query
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// maxSearchTextLength is the maximum number of runes of the searched text.
+const maxSearchTextLength = 256
+
+type SearchUserReviseItemsReadModel interface {
+	// SearchUserReviseItems returns the items of the user whose name, description or tags contain the text,
+	// the items matching by name first. The revisions of the items are not loaded.
+	SearchUserReviseItems(
+		ctx context.Context,
+		userID uuid.UUID,
+		text string,
+		pagination valueobject.Pagination,
+	) ([]ReviseItem, valueobject.PaginationMetadata, error)
+}
+
+type SearchUserReviseItems struct {
+	UserID uuid.UUID `json:"user_id"`
+	// Text is the searched text, all the items of the user match an empty one.
+	Text       string     `json:"text"`
+	Pagination Pagination `json:"pagination"`
+}
+
+type SearchUserReviseItemsHandler struct {
+	readModel SearchUserReviseItemsReadModel
+}
+
+func NewSearchUserReviseItemsHandler(readModel SearchUserReviseItemsReadModel) SearchUserReviseItemsHandler {
+	return SearchUserReviseItemsHandler{readModel: readModel}
+}
+
+func (h SearchUserReviseItemsHandler) Handle(
+	ctx context.Context,
+	query SearchUserReviseItems,
+) ([]ReviseItem, valueobject.PaginationMetadata, error) {
+	const op = "reviseitem.query.search_user_revise_items"
+	if query.UserID.IsNil() {
+		return nil, valueobject.PaginationMetadata{}, errs.NewIncorrectInputError(
+			op,
+			errors.New("user_id must not be nil"),
+			"user_id-must-not-be-nil",
+		)
+	}
+	text := strings.TrimSpace(query.Text)
+	if utf8.RuneCountInString(text) > maxSearchTextLength {
+		return nil, valueobject.PaginationMetadata{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "search text is too long").
+			WithMessages([]errs.Message{{Key: "message", Value: "search text is too long"}}).
+			WithContext("length", utf8.RuneCountInString(text))
+	}
+
+	pagination := valueobject.NewPagination(query.Pagination.Page, query.Pagination.PageSize)
+	items, meta, err := h.readModel.SearchUserReviseItems(ctx, query.UserID, text, pagination)
+	if err != nil {
+		return nil, valueobject.PaginationMetadata{}, errs.WithOp(op, err, "failed to search user revise items")
+	}
+	return items, meta, nil
+}
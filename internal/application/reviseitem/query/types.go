@@ -1,6 +1,7 @@
 package query
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -9,20 +10,50 @@ import (
 )
 
 type ReviseItem struct {
-	ID     uuid.UUID
-	UserID uuid.UUID
+	ID     uuid.UUID `json:"id"`
+	UserID uuid.UUID `json:"user_id"`
 
-	Name        string
-	Description string
-	Tags        valueobject.Tags
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Tags        valueobject.Tags `json:"tags"`
+	// Source is the URL of the source of the item, empty if none.
+	Source string `json:"source"`
 
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt *time.Time
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+	DeletedAt *time.Time `json:"deleted_at"`
 
-	NextRevisionAt time.Time
-	LastRevisedAt  time.Time
-	Revisions      []time.Time
+	NextRevisionAt time.Time  `json:"next_revision_at"`
+	LastRevisedAt  time.Time  `json:"last_revised_at"`
+	Revisions      []Revision `json:"revisions"`
+
+	// IntervalProfileID is the interval profile attached to the item, uuid.Nil if none.
+	IntervalProfileID uuid.UUID `json:"interval_profile_id"`
+}
+
+// Revision is a journal entry of a revise item.
+type Revision struct {
+	ID        uuid.UUID `json:"id"`
+	RevisedAt time.Time `json:"revised_at"`
+	// Kind is one of review, relapse or reset.
+	Kind string `json:"kind"`
+	// Grade is zero for resets and revisions made before grade-based scheduling.
+	Grade valueobject.Grade `json:"grade"`
+	// Lapse marks that the item was forgotten.
+	Lapse bool `json:"lapse"`
+
+	Notes      string                 `json:"notes"`
+	Duration   time.Duration          `json:"-"`
+	Confidence valueobject.Confidence `json:"confidence"`
+}
+
+// MarshalJSON marshals the revision with its duration in whole seconds.
+func (r Revision) MarshalJSON() ([]byte, error) {
+	type revision Revision
+	return json.Marshal(struct {
+		revision
+		DurationSeconds int64 `json:"duration_seconds"`
+	}{revision: revision(r), DurationSeconds: int64(r.Duration / time.Second)})
 }
 
 type Pagination struct {
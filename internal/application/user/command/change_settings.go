@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/gofrs/uuid"
+	"golang.org/x/text/language"
 
 	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
@@ -15,10 +17,28 @@ type UserProvider interface {
 
 // ChangeSettings represents a command to change user settings.
 // It can be used to change user settings by ID and chatID.
+// Only the provided (non-nil) settings are changed, the rest are kept as they are.
 type ChangeSettings struct {
 	ID       uuid.UUID             `json:"user_id"`
 	ChatID   domainUser.TelegramID `json:"chat_id"`
-	Settings domainUser.Settings   `json:"settings"`
+	Language *language.Tag         `json:"language"`
+	// ReminderSlots replace all the reminder slots of the user, an empty list turns reminders off.
+	ReminderSlots *[]domainUser.ReminderSlot `json:"reminder_slots"`
+	// QuietHours with the same start and end turn the quiet hours off.
+	QuietHours      *domainUser.QuietHours      `json:"quiet_hours"`
+	ReviewIntervals *valueobject.ReviewInterval `json:"review_intervals"`
+	// DailyReviewLimit and DailyNewItemLimit change the daily quota, 0 means no limit.
+	DailyReviewLimit  *int                  `json:"daily_review_limit"`
+	DailyNewItemLimit *int                  `json:"daily_new_item_limit"`
+	Timezone          *valueobject.Timezone `json:"timezone"`
+	// Channels replace the notification channels, the email and webhook channels need their address set.
+	Channels *[]domainUser.Channel `json:"channels"`
+	// Email and WebhookURL set the addresses of the channels, an empty string clears them.
+	Email      *string `json:"email"`
+	WebhookURL *string `json:"webhook_url"`
+	// WebhookSecret signs the payloads of the webhook, it is required with the Channels turning the webhook
+	// channel on. The ports generate it with domainUser.NewWebhookSecret and show it to the user once.
+	WebhookSecret string `json:"-"`
 }
 
 type ChangeSettingsHandler struct {
@@ -52,7 +72,44 @@ func (r ChangeSettingsHandler) Handle(ctx context.Context, cmd ChangeSettings) e
 	}
 
 	err := r.userRepo.UpdateUser(ctx, cmd.ID, func(user *domainUser.User) (*domainUser.User, error) {
-		if err := user.UpdateSettings(cmd.Settings); err != nil {
+		settings := user.Settings()
+		if cmd.Language != nil {
+			settings.Language = *cmd.Language
+		}
+		if cmd.ReminderSlots != nil {
+			settings.ReminderSlots = *cmd.ReminderSlots
+		}
+		if cmd.QuietHours != nil {
+			settings.QuietHours = *cmd.QuietHours
+		}
+		if cmd.ReviewIntervals != nil {
+			settings.ReviewIntervals = *cmd.ReviewIntervals
+		}
+		if cmd.DailyReviewLimit != nil {
+			settings.DailyQuota.Reviews = *cmd.DailyReviewLimit
+		}
+		if cmd.DailyNewItemLimit != nil {
+			settings.DailyQuota.NewItems = *cmd.DailyNewItemLimit
+		}
+		if cmd.Timezone != nil {
+			settings.Timezone = *cmd.Timezone
+		}
+		if cmd.Channels != nil {
+			// turning the webhook channel on gets a new secret, turning it off clears the secret
+			settings.Channels = *cmd.Channels
+			settings.WebhookSecret = ""
+			if settings.HasChannel(domainUser.ChannelWebhook) {
+				settings.WebhookSecret = cmd.WebhookSecret
+			}
+		}
+		if cmd.Email != nil {
+			settings.Email = *cmd.Email
+		}
+		if cmd.WebhookURL != nil {
+			settings.WebhookURL = *cmd.WebhookURL
+		}
+
+		if err := user.UpdateSettings(settings); err != nil {
 			return nil, errs.WithOp(op, err, "failed to update user settings")
 		}
 		return user, nil
@@ -31,7 +31,7 @@ func NewGetUserHandler(readModel GetUserReadModel) GetUserHandler {
 
 func (h GetUserHandler) Handle(ctx context.Context, cmd GetUser) (User, error) {
 	op := errs.Op("application.user.query.get_user")
-	if cmd.ID != uuid.Nil && !cmd.ChatID.IsValid() {
+	if cmd.ID == uuid.Nil && !cmd.ChatID.IsValid() {
 		return User{}, errs.
 			NewIncorrectInputError(op, errs.ErrInvalidInput, "id or chat ID must be provided").
 			WithMessages([]errs.Message{{Key: "message", Value: "id or chat ID must be provided"}}).
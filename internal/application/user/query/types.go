@@ -7,11 +7,30 @@ type User struct {
 }
 
 type Settings struct {
-	Language     string       `json:"language"`
-	ReminderTime ReminderTime `json:"reminder_time"`
+	Language string `json:"language"`
+	// ReminderSlots are the times of day in the user's time zone when reminders are sent.
+	ReminderSlots []ReminderSlot `json:"reminder_slots"`
+	// QuietHours is a window in which no reminders are sent, e.g. 22:00-07:00, "off" if not set.
+	QuietHours      string `json:"quiet_hours"`
+	ReviewIntervals string `json:"review_intervals"`
+	// DailyReviewLimit and DailyNewItemLimit are the daily quota, 0 means no limit.
+	DailyReviewLimit  int `json:"daily_review_limit"`
+	DailyNewItemLimit int `json:"daily_new_item_limit"`
+	// Timezone is the IANA time zone name of the user, e.g. Asia/Almaty.
+	Timezone string `json:"timezone"`
+	// Channels are where the reminders are sent: telegram, email or webhook.
+	Channels   []string `json:"channels"`
+	Email      string   `json:"email"`
+	WebhookURL string   `json:"webhook_url"`
 }
 
 type ReminderTime struct {
 	Hour   uint8 `json:"hour"`
 	Minute uint8 `json:"minute"`
 }
+
+type ReminderSlot struct {
+	ReminderTime
+	// Weekdays are the days the reminder is sent on, e.g. daily, workdays or mon,wed,fri.
+	Weekdays string `json:"weekdays"`
+}
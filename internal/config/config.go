@@ -17,6 +17,9 @@ type Config struct {
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout" env:"SHUTDOWN_TIMEOUT" env-default:"10s"`
 	Telegram        Telegram      `yaml:"telegram"`
 	HTTP            HTTP          `yaml:"http"`
+	Scheduler       Scheduler     `yaml:"scheduler"`
+	Notification    Notification  `yaml:"notification"`
+	Webpage         Webpage       `yaml:"webpage"`
 	DatabaseURL     string        `yaml:"database_url"     env:"DATABASE_URL"`
 }
 
@@ -29,6 +32,48 @@ type Telegram struct {
 
 type HTTP struct {
 	Port string `yaml:"port" env:"HTTP_PORT" env-default:"8080"`
+	// AutoRegister registers the unknown telegram users on their first Mini App request
+	// instead of rejecting them until they register in the bot.
+	AutoRegister bool `yaml:"auto_register" env:"HTTP_AUTO_REGISTER" env-default:"false"`
+}
+
+type Scheduler struct {
+	// Algorithm is the spaced repetition algorithm used to schedule reviews: ladder, sm2 or fsrs.
+	// ladder follows the review intervals of the user, sm2 starts with the first two of them
+	// and sm2 and fsrs keep their intervals within the first and the last one.
+	Algorithm string `yaml:"algorithm" env:"SCHEDULER_ALGORITHM" env-default:"sm2"`
+	// LoadBalance spreads the due dates across neighbouring days to avoid review pile-ups.
+	LoadBalance bool `yaml:"load_balance" env:"SCHEDULER_LOAD_BALANCE" env-default:"false"`
+}
+
+type Notification struct {
+	// Interval is how often due reminders are checked.
+	Interval time.Duration `yaml:"interval" env:"NOTIFICATION_INTERVAL" env-default:"1m"`
+	// CatchUp is how far back reminders missed during a downtime are still sent.
+	CatchUp time.Duration `yaml:"catch_up" env:"NOTIFICATION_CATCH_UP" env-default:"6h"`
+	SMTP    SMTP          `yaml:"smtp"`
+	Webhook Webhook       `yaml:"webhook"`
+}
+
+// SMTP configures the email channel, it is disabled if the host is empty.
+type SMTP struct {
+	Host     string `yaml:"host"     env:"SMTP_HOST"`
+	Port     int    `yaml:"port"     env:"SMTP_PORT"     env-default:"587"`
+	Username string `yaml:"username" env:"SMTP_USERNAME"`
+	Password string `yaml:"password" env:"SMTP_PASSWORD"`
+	// From is the sender address of the reminders.
+	From    string        `yaml:"from"    env:"SMTP_FROM"`
+	Timeout time.Duration `yaml:"timeout" env:"SMTP_TIMEOUT" env-default:"30s"`
+}
+
+// Webhook configures the webhook channel, the payloads are signed with the secret of each user.
+type Webhook struct {
+	Timeout time.Duration `yaml:"timeout" env:"WEBHOOK_TIMEOUT" env-default:"10s"`
+}
+
+// Webpage configures the fetching of the titles of the pages the revise items are created from.
+type Webpage struct {
+	Timeout time.Duration `yaml:"timeout" env:"WEBPAGE_TIMEOUT" env-default:"5s"`
 }
 
 func MustLoad() Config {
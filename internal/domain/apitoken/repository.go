@@ -0,0 +1,18 @@
+package apitoken
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+type UpdateFn func(token *Token) (*Token, error)
+
+type Repository interface {
+	// Save saves an api token.
+	Save(ctx context.Context, token Token) error
+	// Update updates an api token.
+	Update(ctx context.Context, id uuid.UUID, fn UpdateFn) error
+	// GetByHash returns the api token with the hash, revoked ones included.
+	GetByHash(ctx context.Context, hash []byte) (*Token, error)
+}
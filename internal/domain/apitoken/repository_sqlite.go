@@ -0,0 +1,173 @@
+package apitoken
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
+)
+
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) SQLiteRepo {
+	return SQLiteRepo{db: db}
+}
+
+// Save saves an api token.
+func (r *SQLiteRepo) Save(ctx context.Context, token Token) error {
+	op := errs.Op("domain.apitoken.sqlite.save")
+	args := sqlc.CreateAPITokenParams{
+		ID:         token.id.String(),
+		UserID:     token.userID.String(),
+		Name:       token.name,
+		TokenHash:  token.hash,
+		Scope:      token.scope.String(),
+		CreatedAt:  token.createdAt,
+		LastUsedAt: timeToNullTime(token.lastUsedAt),
+		RevokedAt:  timeToNullTime(token.revokedAt),
+	}
+
+	err := sqlc.New(r.db).CreateAPIToken(ctx, args)
+	if err != nil {
+		return sqliterr.
+			Handle(op, err, "failed to save api token").
+			WithContext("id", args.ID).
+			WithContext("user_id", args.UserID)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepo) withTx(ctx context.Context, op errs.Op, fn func(*sqlc.Queries) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return sqliterr.HandleTx(op, err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				slog.
+					With(slog.String("op", string(op))).
+					Error("failed to rollback transaction",
+						logutil.Err(rollbackErr),
+						"original_error", err)
+			}
+		}
+	}()
+
+	qtx := sqlc.New(tx)
+	if err = fn(qtx); err != nil {
+		return err // Already wrapped with operation
+	}
+
+	if err = tx.Commit(); err != nil {
+		return sqliterr.HandleTx(op, err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Update updates an api token.
+func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) error {
+	op := errs.Op("domain.apitoken.sqlite.update")
+
+	return r.withTx(ctx, op, func(q *sqlc.Queries) error {
+		model, err := q.GetAPIToken(ctx, id.String())
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to get api token").WithContext("id", id)
+		}
+
+		token, err := fn(modelToToken(model))
+		if err != nil {
+			return errs.WithOp(op, err, "failed to update api token")
+		}
+
+		err = q.UpdateAPIToken(ctx, sqlc.UpdateAPITokenParams{
+			Name:       token.name,
+			Scope:      token.scope.String(),
+			LastUsedAt: timeToNullTime(token.lastUsedAt),
+			RevokedAt:  timeToNullTime(token.revokedAt),
+			ID:         token.id.String(),
+		})
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to update api token").WithContext("id", id)
+		}
+
+		return nil
+	})
+}
+
+// GetByHash returns the api token with the hash, revoked ones included.
+func (r *SQLiteRepo) GetByHash(ctx context.Context, hash []byte) (*Token, error) {
+	op := errs.Op("domain.apitoken.sqlite.get_by_hash")
+
+	model, err := sqlc.New(r.db).GetAPITokenByHash(ctx, hash)
+	if err != nil {
+		return nil, sqliterr.Handle(op, err, "failed to get api token")
+	}
+	return modelToToken(model), nil
+}
+
+// --- Query read model implementation ---
+
+// ListUserAPITokens returns the active api tokens of the user, the newest first.
+func (r *SQLiteRepo) ListUserAPITokens(ctx context.Context, userID uuid.UUID) ([]query.APIToken, error) {
+	op := errs.Op("domain.apitoken.sqlite.list_user_api_tokens")
+
+	models, err := sqlc.New(r.db).ListUserAPITokens(ctx, userID.String())
+	if err != nil {
+		return nil, sqliterr.Handle(op, err, "failed to list user api tokens").WithContext("user_id", userID)
+	}
+
+	tokens := make([]query.APIToken, 0, len(models))
+	for _, model := range models {
+		token := query.APIToken{
+			ID:        uuid.FromStringOrNil(model.ID),
+			UserID:    uuid.FromStringOrNil(model.UserID),
+			Name:      model.Name,
+			Scope:     model.Scope,
+			CreatedAt: model.CreatedAt,
+		}
+		if model.LastUsedAt.Valid {
+			token.LastUsedAt = &model.LastUsedAt.Time
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
+
+func modelToToken(m sqlc.ApiToken) *Token {
+	t := &Token{
+		id:        uuid.FromStringOrNil(m.ID),
+		userID:    uuid.FromStringOrNil(m.UserID),
+		name:      m.Name,
+		hash:      m.TokenHash,
+		scope:     Scope(m.Scope),
+		createdAt: m.CreatedAt,
+	}
+	if m.LastUsedAt.Valid {
+		t.lastUsedAt = m.LastUsedAt.Time
+	}
+	if m.RevokedAt.Valid {
+		t.revokedAt = m.RevokedAt.Time
+	}
+	return t
+}
+
+func timeToNullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
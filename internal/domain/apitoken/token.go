@@ -0,0 +1,216 @@
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+var ErrInvalidToken = errors.New("invalid api token")
+
+const (
+	// Prefix starts every token, so the tokens leaked into code or logs are easy to find.
+	Prefix = "grv_"
+	// secretLength is the number of the random bytes of a token.
+	secretLength = 32
+	// maxNameLength limits the name the user recognizes the token by.
+	maxNameLength = 64
+	// usedAtResolution is how often the last use of a token is written, not to write on every request.
+	usedAtResolution = time.Minute
+)
+
+// Scope is what a token is allowed to do.
+type Scope string
+
+const (
+	// ScopeRead tokens can only read, e.g. list the revise items.
+	ScopeRead Scope = "read"
+	// ScopeWrite tokens can also change the data, it includes ScopeRead.
+	ScopeWrite Scope = "write"
+)
+
+// ParseScope parses the scope name, read or write.
+func ParseScope(s string) (Scope, error) {
+	op := errs.Op("domain.apitoken.parse_scope")
+	switch scope := Scope(strings.ToLower(strings.TrimSpace(s))); scope {
+	case ScopeRead, ScopeWrite:
+		return scope, nil
+	default:
+		return "", errs.
+			NewIncorrectInputError(op, ErrInvalidToken, "invalid scope").
+			WithMessages([]errs.Message{{Key: "message", Value: "scope must be one of: read, write"}}).
+			WithContext("scope", s)
+	}
+}
+
+func (s Scope) String() string {
+	return string(s)
+}
+
+// Includes reports whether the scope grants the other one.
+func (s Scope) Includes(other Scope) bool {
+	return s == other || s == ScopeWrite && other == ScopeRead
+}
+
+// Token is a personal access token of the REST API. The token itself is shown to the user once,
+// only its hash is kept.
+type Token struct {
+	id     uuid.UUID
+	userID uuid.UUID
+
+	name  string
+	hash  []byte
+	scope Scope
+
+	createdAt  time.Time
+	lastUsedAt time.Time
+	revokedAt  time.Time
+}
+
+// NewTokenID creates a new api token ID.
+func NewTokenID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
+
+type NewTokenArgs struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	Name   string
+	Scope  Scope
+}
+
+// NewToken creates a new token, it returns the token the user authorizes with alongside.
+func NewToken(args NewTokenArgs) (*Token, string, error) {
+	op := errs.Op("domain.apitoken.new_token")
+	if args.ID == uuid.Nil {
+		return nil, "", errs.
+			NewUnknownError(op, ErrInvalidToken, "api token id is nil").
+			WithContext("args.id", args.ID)
+	}
+	if args.UserID == uuid.Nil {
+		return nil, "", errs.
+			NewIncorrectInputError(op, ErrInvalidToken, "user id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user id must be provided"}}).
+			WithContext("args.UserID", args.UserID)
+	}
+	args.Name = strings.TrimSpace(args.Name)
+	if err := validateName(args.Name); err != nil {
+		return nil, "", errs.WithOp(op, err, "validating api token name failed")
+	}
+	if _, err := ParseScope(args.Scope.String()); err != nil {
+		return nil, "", errs.WithOp(op, err, "validating api token scope failed")
+	}
+
+	random := make([]byte, secretLength)
+	if _, err := rand.Read(random); err != nil {
+		return nil, "", errs.NewUnknownError(op, err, "failed to generate api token")
+	}
+	secret := Prefix + base64.RawURLEncoding.EncodeToString(random)
+
+	return &Token{
+		id:        args.ID,
+		userID:    args.UserID,
+		name:      args.Name,
+		hash:      Hash(secret),
+		scope:     args.Scope,
+		createdAt: time.Now(),
+	}, secret, nil
+}
+
+// Hash returns the hash the token is stored and looked up by. The tokens are random,
+// so a fast hash is enough to keep them useless if the database leaks.
+func Hash(secret string) []byte {
+	sum := sha256.Sum256([]byte(secret))
+	return sum[:]
+}
+
+func (t *Token) ID() uuid.UUID {
+	return t.id
+}
+
+func (t *Token) UserID() uuid.UUID {
+	return t.userID
+}
+
+func (t *Token) Name() string {
+	return t.name
+}
+
+func (t *Token) Hash() []byte {
+	return t.hash
+}
+
+func (t *Token) Scope() Scope {
+	return t.scope
+}
+
+func (t *Token) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+// LastUsedAt returns when the token was last used, zero if never.
+func (t *Token) LastUsedAt() time.Time {
+	return t.lastUsedAt
+}
+
+// RevokedAt returns when the token was revoked, zero if it is active.
+func (t *Token) RevokedAt() time.Time {
+	return t.revokedAt
+}
+
+func (t *Token) IsRevoked() bool {
+	return !t.revokedAt.IsZero()
+}
+
+func (t *Token) CanModify(userID uuid.UUID) bool {
+	return t.userID == userID
+}
+
+// Revoke revokes the token, it can not be used anymore.
+func (t *Token) Revoke(now time.Time) error {
+	op := errs.Op("domain.apitoken.revoke")
+	if t.IsRevoked() {
+		return errs.
+			NewConflictError(op, ErrInvalidToken, "api token is already revoked").
+			WithMessages([]errs.Message{{Key: "message", Value: "api token is already revoked"}}).
+			WithContext("id", t.id)
+	}
+	t.revokedAt = now
+	return nil
+}
+
+// MarkUsed records the use of the token, it returns false if the last use is recent enough to keep.
+func (t *Token) MarkUsed(now time.Time) bool {
+	if now.Sub(t.lastUsedAt) < usedAtResolution {
+		return false
+	}
+	t.lastUsedAt = now
+	return true
+}
+
+func validateName(name string) error {
+	op := errs.Op("domain.apitoken.validate_name")
+
+	err := validation.Validate(
+		name,
+		validation.Required.Error("name is required"),
+		validation.RuneLength(1, maxNameLength).
+			Error(fmt.Sprintf("name must be between 1 and %d characters", maxNameLength)),
+	)
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidToken, "invalid name").
+			WithMessages([]errs.Message{{Key: "message", Value: err.Error()}}).
+			WithContext("name", name)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package apitoken
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+func TestNewToken(t *testing.T) {
+	t.Parallel()
+
+	validArgs := NewTokenArgs{
+		ID:     NewTokenID(),
+		UserID: uuid.Must(uuid.NewV7()),
+		Name:   " obsidian sync ",
+		Scope:  ScopeWrite,
+	}
+
+	t.Run("Expect the hash of the returned token to be kept", func(t *testing.T) {
+		token, secret, err := NewToken(validArgs)
+		require.NoError(t, err)
+
+		assert.True(t, strings.HasPrefix(secret, Prefix))
+		assert.Equal(t, Hash(secret), token.Hash())
+		assert.Equal(t, "obsidian sync", token.Name())
+		assert.Equal(t, ScopeWrite, token.Scope())
+		assert.False(t, token.IsRevoked())
+		assert.True(t, token.LastUsedAt().IsZero())
+	})
+
+	t.Run("Expect every token to be different", func(t *testing.T) {
+		_, first, err := NewToken(validArgs)
+		require.NoError(t, err)
+		_, second, err := NewToken(validArgs)
+		require.NoError(t, err)
+		assert.NotEqual(t, first, second)
+	})
+
+	tests := []struct {
+		name string
+		args func(args NewTokenArgs) NewTokenArgs
+	}{
+		{name: "With nil user id", args: func(a NewTokenArgs) NewTokenArgs { a.UserID = uuid.Nil; return a }},
+		{name: "With empty name", args: func(a NewTokenArgs) NewTokenArgs { a.Name = "  "; return a }},
+		{name: "With long name", args: func(a NewTokenArgs) NewTokenArgs { a.Name = strings.Repeat("a", 65); return a }},
+		{name: "With unknown scope", args: func(a NewTokenArgs) NewTokenArgs { a.Scope = "admin"; return a }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := NewToken(tt.args(validArgs))
+			assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+			assert.ErrorIs(t, err, ErrInvalidToken)
+		})
+	}
+}
+
+func TestScope_Includes(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ScopeRead.Includes(ScopeRead))
+	assert.False(t, ScopeRead.Includes(ScopeWrite))
+	assert.True(t, ScopeWrite.Includes(ScopeRead))
+	assert.True(t, ScopeWrite.Includes(ScopeWrite))
+}
+
+func TestToken_Revoke(t *testing.T) {
+	t.Parallel()
+
+	token, _, err := NewToken(NewTokenArgs{
+		ID:     NewTokenID(),
+		UserID: uuid.Must(uuid.NewV7()),
+		Name:   "cli",
+		Scope:  ScopeRead,
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC)
+	require.NoError(t, token.Revoke(now))
+	assert.True(t, token.IsRevoked())
+	assert.Equal(t, now, token.RevokedAt())
+
+	err = token.Revoke(now.Add(time.Hour))
+	assert.True(t, errs.IsErrorType(err, errs.ErrorTypeConflict))
+	assert.Equal(t, now, token.RevokedAt())
+}
+
+func TestToken_MarkUsed(t *testing.T) {
+	t.Parallel()
+
+	token := &Token{}
+	now := time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC)
+
+	assert.True(t, token.MarkUsed(now))
+	assert.False(t, token.MarkUsed(now.Add(30*time.Second)), "expect the recent use to be kept")
+	assert.Equal(t, now, token.LastUsedAt())
+	assert.True(t, token.MarkUsed(now.Add(usedAtResolution)))
+	assert.Equal(t, now.Add(usedAtResolution), token.LastUsedAt())
+}
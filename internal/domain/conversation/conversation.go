@@ -0,0 +1,95 @@
+// Package conversation keeps the state of the multi-step conversations of the bot with a chat,
+// e.g. the values of the revise item being created, so they survive restarts.
+package conversation
+
+import (
+	"time"
+)
+
+// TTL is how long the bot waits for the next message of the conversation.
+const TTL = 30 * time.Minute
+
+// Flow is the kind of the conversation.
+type Flow string
+
+const (
+	// FlowCreateItem creates a revise item step by step.
+	FlowCreateItem Flow = "create_item"
+	// FlowEditItem changes a field of a revise item.
+	FlowEditItem Flow = "edit_item"
+)
+
+// Step is the value the bot waits for in the conversation, its meaning depends on the flow.
+type Step string
+
+// Conversation is the state of the conversation of the bot with a chat, a chat has a single conversation at a time,
+// starting a new one replaces the previous one.
+type Conversation struct {
+	chatID    int64
+	flow      Flow
+	step      Step
+	data      map[string]string
+	expiresAt time.Time
+	updatedAt time.Time
+}
+
+// New starts a conversation of the flow in the chat at the step.
+func New(chatID int64, flow Flow, step Step, now time.Time) Conversation {
+	return Conversation{
+		chatID:    chatID,
+		flow:      flow,
+		step:      step,
+		data:      make(map[string]string),
+		expiresAt: now.Add(TTL),
+		updatedAt: now,
+	}
+}
+
+func (c *Conversation) ChatID() int64 {
+	return c.chatID
+}
+
+func (c *Conversation) Flow() Flow {
+	return c.flow
+}
+
+func (c *Conversation) Step() Step {
+	return c.step
+}
+
+func (c *Conversation) ExpiresAt() time.Time {
+	return c.expiresAt
+}
+
+func (c *Conversation) UpdatedAt() time.Time {
+	return c.updatedAt
+}
+
+// Value returns the collected value of the key, empty if there is none.
+func (c *Conversation) Value(key string) string {
+	return c.data[key]
+}
+
+// Set sets the collected value of the key, an empty value removes it.
+func (c *Conversation) Set(key, value string) {
+	if c.data == nil {
+		c.data = make(map[string]string)
+	}
+	if value == "" {
+		delete(c.data, key)
+		return
+	}
+	c.data[key] = value
+}
+
+// MoveTo moves the conversation to the step and gives the user another TTL to answer.
+func (c *Conversation) MoveTo(step Step, now time.Time) {
+	c.step = step
+	c.expiresAt = now.Add(TTL)
+	c.updatedAt = now
+}
+
+// Expired reports whether the user did not answer in time, the expired conversations are ignored.
+func (c *Conversation) Expired(now time.Time) bool {
+	return !now.Before(c.expiresAt)
+}
@@ -0,0 +1,42 @@
+package conversation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConversation_MoveTo(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC)
+	c := New(42, FlowCreateItem, "name", now)
+
+	t.Run("Expect to expire after TTL", func(t *testing.T) {
+		assert.False(t, c.Expired(now.Add(TTL-time.Second)))
+		assert.True(t, c.Expired(now.Add(TTL)))
+	})
+
+	later := now.Add(TTL - time.Second)
+	c.MoveTo("description", later)
+
+	t.Run("Expect the step to be moved and the expiry to be extended", func(t *testing.T) {
+		assert.Equal(t, Step("description"), c.Step())
+		assert.Equal(t, later, c.UpdatedAt())
+		assert.False(t, c.Expired(now.Add(TTL)))
+		assert.True(t, c.Expired(later.Add(TTL)))
+	})
+}
+
+func TestConversation_Set(t *testing.T) {
+	t.Parallel()
+
+	var c Conversation
+	c.Set("name", "Go maps")
+	assert.Equal(t, "Go maps", c.Value("name"))
+
+	c.Set("name", "")
+	assert.Empty(t, c.Value("name"))
+	assert.NotContains(t, c.data, "name")
+}
@@ -0,0 +1,14 @@
+package conversation
+
+import (
+	"context"
+)
+
+type Repository interface {
+	// Get returns the conversation of the chat, a not found error if there is none.
+	Get(ctx context.Context, chatID int64) (Conversation, error)
+	// Save saves the conversation, replacing the previous conversation of the chat.
+	Save(ctx context.Context, c Conversation) error
+	// Delete ends the conversation of the chat, it is a no-op if there is none.
+	Delete(ctx context.Context, chatID int64) error
+}
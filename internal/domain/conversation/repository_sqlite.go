@@ -0,0 +1,83 @@
+package conversation
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) SQLiteRepo {
+	return SQLiteRepo{db: db}
+}
+
+// Get returns the conversation of the chat, a not found error if there is none.
+func (r *SQLiteRepo) Get(ctx context.Context, chatID int64) (Conversation, error) {
+	op := errs.Op("domain.conversation.sqlite.get")
+	q := sqlc.New(r.db)
+
+	model, err := q.GetConversation(ctx, chatID)
+	if err != nil {
+		return Conversation{}, sqliterr.Handle(op, err, "failed to get conversation").WithContext("chat_id", chatID)
+	}
+
+	data := make(map[string]string)
+	if err := json.Unmarshal([]byte(model.Data), &data); err != nil {
+		return Conversation{}, errs.
+			NewUnknownError(op, err, "failed to unmarshal conversation data").
+			WithContext("chat_id", chatID)
+	}
+
+	return Conversation{
+		chatID:    model.ChatID,
+		flow:      Flow(model.Flow),
+		step:      Step(model.Step),
+		data:      data,
+		expiresAt: model.ExpiresAt,
+		updatedAt: model.UpdatedAt,
+	}, nil
+}
+
+// Save saves the conversation, replacing the previous conversation of the chat.
+func (r *SQLiteRepo) Save(ctx context.Context, c Conversation) error {
+	op := errs.Op("domain.conversation.sqlite.save")
+	q := sqlc.New(r.db)
+
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return errs.
+			NewUnknownError(op, err, "failed to marshal conversation data").
+			WithContext("chat_id", c.chatID)
+	}
+
+	err = q.SaveConversation(ctx, sqlc.SaveConversationParams{
+		ChatID:    c.chatID,
+		Flow:      string(c.flow),
+		Step:      string(c.step),
+		Data:      string(data),
+		ExpiresAt: c.expiresAt.UTC(),
+		UpdatedAt: c.updatedAt.UTC(),
+	})
+	if err != nil {
+		return sqliterr.Handle(op, err, "failed to save conversation").WithContext("chat_id", c.chatID)
+	}
+	return nil
+}
+
+// Delete ends the conversation of the chat, it is a no-op if there is none.
+func (r *SQLiteRepo) Delete(ctx context.Context, chatID int64) error {
+	op := errs.Op("domain.conversation.sqlite.delete")
+	q := sqlc.New(r.db)
+
+	if err := q.DeleteConversation(ctx, chatID); err != nil {
+		return sqliterr.Handle(op, err, "failed to delete conversation").WithContext("chat_id", chatID)
+	}
+	return nil
+}
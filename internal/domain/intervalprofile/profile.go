@@ -0,0 +1,147 @@
+package intervalprofile
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+var ErrInvalidProfile = errors.New("invalid interval profile")
+
+const maxNameLength = 64
+
+// Profile is a named ladder of review intervals.
+// It can be attached to a tag or to a revise item to override the intervals of the user.
+type Profile struct {
+	id     uuid.UUID
+	userID uuid.UUID
+
+	name      string
+	intervals valueobject.ReviewInterval
+
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// NewProfileID creates a new interval profile ID.
+func NewProfileID() uuid.UUID {
+	return uuid.Must(uuid.NewV7())
+}
+
+type NewProfileArgs struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Intervals valueobject.ReviewInterval
+}
+
+// NewProfile creates a new interval profile. It returns an error if the arguments are invalid.
+func NewProfile(args NewProfileArgs) (*Profile, error) {
+	op := errs.Op("domain.intervalprofile.new_profile")
+	if args.ID == uuid.Nil {
+		return nil, errs.
+			NewUnknownError(op, ErrInvalidProfile, "interval profile id is nil").
+			WithContext("args.id", args.ID)
+	}
+	if args.UserID == uuid.Nil {
+		return nil, errs.
+			NewIncorrectInputError(op, ErrInvalidProfile, "user id must be provided").
+			WithMessages([]errs.Message{{Key: "message", Value: "user id must be provided"}}).
+			WithContext("args.UserID", args.UserID)
+	}
+	args.Name = strings.TrimSpace(args.Name)
+	if err := validateName(args.Name); err != nil {
+		return nil, errs.WithOp(op, err, "validating interval profile name failed")
+	}
+	if err := args.Intervals.Validate(); err != nil {
+		return nil, errs.WithOp(op, err, "validating interval profile intervals failed")
+	}
+
+	now := time.Now()
+	return &Profile{
+		id:        args.ID,
+		userID:    args.UserID,
+		name:      args.Name,
+		intervals: args.Intervals,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func (p *Profile) ID() uuid.UUID {
+	return p.id
+}
+
+func (p *Profile) UserID() uuid.UUID {
+	return p.userID
+}
+
+func (p *Profile) Name() string {
+	return p.name
+}
+
+func (p *Profile) Intervals() valueobject.ReviewInterval {
+	return p.intervals
+}
+
+func (p *Profile) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *Profile) UpdatedAt() time.Time {
+	return p.updatedAt
+}
+
+func (p *Profile) Rename(name string) error {
+	op := errs.Op("domain.intervalprofile.rename")
+	name = strings.TrimSpace(name)
+	if err := validateName(name); err != nil {
+		return errs.WithOp(op, err, "name validation failed")
+	}
+
+	p.name = name
+	p.updatedAt = time.Now()
+
+	return nil
+}
+
+func (p *Profile) ChangeIntervals(intervals valueobject.ReviewInterval) error {
+	op := errs.Op("domain.intervalprofile.change_intervals")
+	if err := intervals.Validate(); err != nil {
+		return errs.WithOp(op, err, "intervals validation failed")
+	}
+
+	p.intervals = intervals
+	p.updatedAt = time.Now()
+
+	return nil
+}
+
+func (p *Profile) CanModify(userID uuid.UUID) bool {
+	return p.userID == userID
+}
+
+func validateName(name string) error {
+	op := errs.Op("domain.intervalprofile.validate_name")
+
+	err := validation.Validate(
+		name,
+		validation.Required.Error("name is required"),
+		validation.RuneLength(1, maxNameLength).
+			Error(fmt.Sprintf("name must be between 1 and %d characters", maxNameLength)),
+	)
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidProfile, "invalid name").
+			WithMessages([]errs.Message{{Key: "message", Value: err.Error()}}).
+			WithContext("name", name)
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package intervalprofile
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/clarify/subtest"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestNewProfile(t *testing.T) {
+	t.Parallel()
+
+	profileID := NewProfileID()
+	userID := uuid.Must(uuid.NewV7())
+	intervals := mustParseIntervals(t, "1h 6h 1d")
+
+	tests := []struct {
+		name    string
+		args    NewProfileArgs
+		wantErr bool
+	}{
+		{
+			name: "With valid arguments",
+			args: NewProfileArgs{ID: profileID, UserID: userID, Name: "vocabulary", Intervals: intervals},
+		},
+		{
+			name:    "With nil id",
+			args:    NewProfileArgs{UserID: userID, Name: "vocabulary", Intervals: intervals},
+			wantErr: true,
+		},
+		{
+			name:    "With nil user id",
+			args:    NewProfileArgs{ID: profileID, Name: "vocabulary", Intervals: intervals},
+			wantErr: true,
+		},
+		{
+			name:    "With empty name",
+			args:    NewProfileArgs{ID: profileID, UserID: userID, Name: "  ", Intervals: intervals},
+			wantErr: true,
+		},
+		{
+			name: "With too long name",
+			args: NewProfileArgs{
+				ID:        profileID,
+				UserID:    userID,
+				Name:      strings.Repeat("a", maxNameLength+1),
+				Intervals: intervals,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "With zero intervals",
+			args:    NewProfileArgs{ID: profileID, UserID: userID, Name: "vocabulary"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewProfile(tt.args)
+			if tt.wantErr {
+				t.Run("Expect error", subtest.Value(err).Error())
+				return
+			}
+
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect profile", func(t *testing.T) {
+				assert.Equal(t, tt.args.ID, got.ID())
+				assert.Equal(t, tt.args.UserID, got.UserID())
+				assert.Equal(t, tt.args.Name, got.Name())
+				assert.Equal(t, tt.args.Intervals, got.Intervals())
+			})
+		})
+	}
+}
+
+func mustParseIntervals(t *testing.T, s string) valueobject.ReviewInterval {
+	t.Helper()
+
+	intervals, err := valueobject.ParseReviewInterval(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return intervals
+}
@@ -0,0 +1,22 @@
+package intervalprofile
+
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+)
+
+type UpdateFn func(profile *Profile) (*Profile, error)
+
+type Repository interface {
+	// Save saves an interval profile.
+	Save(ctx context.Context, profile Profile) error
+	// Update updates an interval profile.
+	Update(ctx context.Context, id uuid.UUID, fn UpdateFn) error
+	// Delete deletes an interval profile and detaches it from tags and revise items.
+	Delete(ctx context.Context, id uuid.UUID) error
+	// AttachToTag attaches the profile to the user's tag, replacing the previously attached one.
+	AttachToTag(ctx context.Context, userID uuid.UUID, tag string, profileID uuid.UUID) error
+	// DetachFromTag detaches the profile from the user's tag.
+	DetachFromTag(ctx context.Context, userID uuid.UUID, tag string) error
+}
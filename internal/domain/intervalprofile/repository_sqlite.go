@@ -0,0 +1,277 @@
+package intervalprofile
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
+)
+
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) SQLiteRepo {
+	return SQLiteRepo{db: db}
+}
+
+// Save saves an interval profile.
+func (r *SQLiteRepo) Save(ctx context.Context, profile Profile) error {
+	op := errs.Op("domain.intervalprofile.sqlite.save")
+	args := sqlc.CreateIntervalProfileParams{
+		ID:        profile.id.String(),
+		UserID:    profile.userID.String(),
+		Name:      profile.name,
+		Intervals: profile.intervals.String(),
+		CreatedAt: profile.createdAt,
+		UpdatedAt: profile.updatedAt,
+	}
+
+	err := sqlc.New(r.db).CreateIntervalProfile(ctx, args)
+	if err != nil {
+		return sqliterr.Handle(op, err, "failed to save interval profile").WithContext("args", args)
+	}
+
+	return nil
+}
+
+func (r *SQLiteRepo) withTx(ctx context.Context, op errs.Op, fn func(*sqlc.Queries) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return sqliterr.HandleTx(op, err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				slog.
+					With(slog.String("op", string(op))).
+					Error("failed to rollback transaction",
+						logutil.Err(rollbackErr),
+						"original_error", err)
+			}
+		}
+	}()
+
+	qtx := sqlc.New(tx)
+	if err = fn(qtx); err != nil {
+		return err // Already wrapped with operation
+	}
+
+	if err = tx.Commit(); err != nil {
+		return sqliterr.HandleTx(op, err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Update updates an interval profile.
+func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) error {
+	op := errs.Op("domain.intervalprofile.sqlite.update")
+
+	return r.withTx(ctx, op, func(q *sqlc.Queries) error {
+		model, err := q.GetIntervalProfile(ctx, id.String())
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to get interval profile").WithContext("id", id)
+		}
+
+		profile, err := modelToProfile(model)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to convert model to interval profile")
+		}
+
+		profile, err = fn(profile)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to update interval profile")
+		}
+
+		err = q.UpdateIntervalProfile(ctx, sqlc.UpdateIntervalProfileParams{
+			Name:      profile.Name(),
+			Intervals: profile.Intervals().String(),
+			UpdatedAt: profile.UpdatedAt(),
+			ID:        profile.ID().String(),
+		})
+		if err != nil {
+			return sqliterr.
+				Handle(op, err, "failed to update interval profile").
+				WithContext("id", id)
+		}
+
+		return nil
+	})
+}
+
+// Delete deletes an interval profile and detaches it from tags and revise items.
+func (r *SQLiteRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	op := errs.Op("domain.intervalprofile.sqlite.delete")
+
+	return r.withTx(ctx, op, func(q *sqlc.Queries) error {
+		err := q.ClearReviseItemsIntervalProfile(ctx, sql.NullString{String: id.String(), Valid: true})
+		if err != nil {
+			return sqliterr.
+				Handle(op, err, "failed to detach interval profile from revise items").
+				WithContext("id", id)
+		}
+		if err := q.DeleteIntervalProfileTags(ctx, id.String()); err != nil {
+			return sqliterr.
+				Handle(op, err, "failed to detach interval profile from tags").
+				WithContext("id", id)
+		}
+		if err := q.DeleteIntervalProfile(ctx, id.String()); err != nil {
+			return sqliterr.Handle(op, err, "failed to delete interval profile").WithContext("id", id)
+		}
+		return nil
+	})
+}
+
+// AttachToTag attaches the profile to the user's tag, replacing the previously attached one.
+func (r *SQLiteRepo) AttachToTag(
+	ctx context.Context,
+	userID uuid.UUID,
+	tag string,
+	profileID uuid.UUID,
+) error {
+	op := errs.Op("domain.intervalprofile.sqlite.attach_to_tag")
+	args := sqlc.AttachTagIntervalProfileParams{
+		UserID:    userID.String(),
+		Tag:       tag,
+		ProfileID: profileID.String(),
+	}
+
+	if err := sqlc.New(r.db).AttachTagIntervalProfile(ctx, args); err != nil {
+		return sqliterr.Handle(op, err, "failed to attach interval profile to tag").WithContext("args", args)
+	}
+	return nil
+}
+
+// DetachFromTag detaches the profile from the user's tag.
+func (r *SQLiteRepo) DetachFromTag(ctx context.Context, userID uuid.UUID, tag string) error {
+	op := errs.Op("domain.intervalprofile.sqlite.detach_from_tag")
+	args := sqlc.DetachTagIntervalProfileParams{
+		UserID: userID.String(),
+		Tag:    tag,
+	}
+
+	if err := sqlc.New(r.db).DetachTagIntervalProfile(ctx, args); err != nil {
+		return sqliterr.Handle(op, err, "failed to detach interval profile from tag").WithContext("args", args)
+	}
+	return nil
+}
+
+// --- Resolver providers implementation ---
+
+func (r *SQLiteRepo) GetIntervalProfile(ctx context.Context, id uuid.UUID) (*Profile, error) {
+	op := errs.Op("domain.intervalprofile.sqlite.get_interval_profile")
+
+	model, err := sqlc.New(r.db).GetIntervalProfile(ctx, id.String())
+	if err != nil {
+		return nil, sqliterr.Handle(op, err, "failed to get interval profile").WithContext("id", id)
+	}
+
+	profile, err := modelToProfile(model)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert model to interval profile")
+	}
+	return profile, nil
+}
+
+func (r *SQLiteRepo) GetTagIntervalProfiles(
+	ctx context.Context,
+	userID uuid.UUID,
+) (map[string]Profile, error) {
+	op := errs.Op("domain.intervalprofile.sqlite.get_tag_interval_profiles")
+
+	rows, err := sqlc.New(r.db).ListUserTagIntervalProfiles(ctx, userID.String())
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to list user tag interval profiles").
+			WithContext("user_id", userID)
+	}
+
+	profiles := make(map[string]Profile, len(rows))
+	for _, row := range rows {
+		profile, err := modelToProfile(sqlc.IntervalProfile{
+			ID:        row.ID,
+			UserID:    row.UserID,
+			Name:      row.Name,
+			Intervals: row.Intervals,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to convert model to interval profile")
+		}
+		profiles[row.Tag] = *profile
+	}
+	return profiles, nil
+}
+
+// --- Query read models implementation ---
+
+func (r *SQLiteRepo) ListUserIntervalProfiles(
+	ctx context.Context,
+	userID uuid.UUID,
+) ([]query.IntervalProfile, error) {
+	op := errs.Op("domain.intervalprofile.sqlite.list_user_interval_profiles")
+	q := sqlc.New(r.db)
+
+	models, err := q.ListUserIntervalProfiles(ctx, userID.String())
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to list user interval profiles").
+			WithContext("user_id", userID)
+	}
+
+	tagRows, err := q.ListUserTagIntervalProfiles(ctx, userID.String())
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to list user tag interval profiles").
+			WithContext("user_id", userID)
+	}
+	tags := make(map[string][]string, len(models))
+	for _, row := range tagRows {
+		tags[row.ID] = append(tags[row.ID], row.Tag)
+	}
+
+	profiles := make([]query.IntervalProfile, 0, len(models))
+	for _, model := range models {
+		profiles = append(profiles, query.IntervalProfile{
+			ID:        uuid.FromStringOrNil(model.ID),
+			UserID:    uuid.FromStringOrNil(model.UserID),
+			Name:      model.Name,
+			Intervals: model.Intervals,
+			Tags:      tags[model.ID],
+			CreatedAt: model.CreatedAt,
+			UpdatedAt: model.UpdatedAt,
+		})
+	}
+	return profiles, nil
+}
+
+func modelToProfile(model sqlc.IntervalProfile) (*Profile, error) {
+	const op = "domain.intervalprofile.sqlite.model_to_profile"
+
+	intervals, err := valueobject.ParseReviewInterval(model.Intervals)
+	if err != nil {
+		return nil, errs.
+			NewUnknownError(op, err, "failed to parse intervals").
+			WithContext("intervals", model.Intervals)
+	}
+
+	return &Profile{
+		id:        uuid.FromStringOrNil(model.ID),
+		userID:    uuid.FromStringOrNil(model.UserID),
+		name:      model.Name,
+		intervals: intervals,
+		createdAt: model.CreatedAt,
+		updatedAt: model.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package intervalprofile
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Resolve returns the review intervals of a revise item, the most specific source wins:
+//
+//	item profile → tag profile → user intervals → default intervals
+//
+// If several tags of the item have a profile, the tag that comes first alphabetically wins.
+func Resolve(
+	itemProfile *Profile,
+	tagProfiles map[string]Profile,
+	tags valueobject.Tags,
+	userIntervals valueobject.ReviewInterval,
+) valueobject.ReviewInterval {
+	if itemProfile != nil {
+		return itemProfile.Intervals()
+	}
+
+	sorted := slices.Clone(tags.StringArray())
+	slices.Sort(sorted)
+	for _, tag := range sorted {
+		if profile, ok := tagProfiles[tag]; ok {
+			return profile.Intervals()
+		}
+	}
+
+	if !userIntervals.IsZero() {
+		return userIntervals
+	}
+	return valueobject.DefaultReviewIntervals()
+}
+
+// ProfileProvider provides the interval profiles of users.
+type ProfileProvider interface {
+	GetIntervalProfile(ctx context.Context, id uuid.UUID) (*Profile, error)
+	// GetTagIntervalProfiles returns the profiles attached to the user's tags, by tag.
+	GetTagIntervalProfiles(ctx context.Context, userID uuid.UUID) (map[string]Profile, error)
+}
+
+// UserIntervalsProvider provides the review intervals configured by the user.
+type UserIntervalsProvider interface {
+	GetUserReviewIntervals(ctx context.Context, userID uuid.UUID) (valueobject.ReviewInterval, error)
+}
+
+// Resolver loads every source of review intervals of a revise item and resolves them.
+type Resolver struct {
+	profiles ProfileProvider
+	users    UserIntervalsProvider
+}
+
+func NewResolver(profiles ProfileProvider, users UserIntervalsProvider) Resolver {
+	return Resolver{profiles: profiles, users: users}
+}
+
+// ResolveReviewIntervals returns the review intervals of the user's revise item
+// with the given interval profile (uuid.Nil if none) and tags.
+func (r Resolver) ResolveReviewIntervals(
+	ctx context.Context,
+	userID, profileID uuid.UUID,
+	tags valueobject.Tags,
+) (valueobject.ReviewInterval, error) {
+	op := errs.Op("domain.intervalprofile.resolver.resolve_review_intervals")
+
+	var itemProfile *Profile
+	if !profileID.IsNil() {
+		profile, err := r.profiles.GetIntervalProfile(ctx, profileID)
+		if err != nil {
+			return valueobject.ReviewInterval{}, errs.WithOp(op, err, "failed to get item interval profile")
+		}
+		// profiles of other users are never applied
+		if profile.CanModify(userID) {
+			itemProfile = profile
+		}
+	}
+
+	var tagProfiles map[string]Profile
+	if itemProfile == nil && !tags.IsEmpty() {
+		var err error
+		tagProfiles, err = r.profiles.GetTagIntervalProfiles(ctx, userID)
+		if err != nil {
+			return valueobject.ReviewInterval{}, errs.WithOp(op, err, "failed to get tag interval profiles")
+		}
+	}
+
+	userIntervals, err := r.users.GetUserReviewIntervals(ctx, userID)
+	if err != nil {
+		return valueobject.ReviewInterval{}, errs.WithOp(op, err, "failed to get user review intervals")
+	}
+
+	return Resolve(itemProfile, tagProfiles, tags, userIntervals), nil
+}
@@ -0,0 +1,140 @@
+package intervalprofile
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	itemProfile := mustNewProfile(t, userID, "item", "1m")
+	vocabulary := mustNewProfile(t, userID, "vocabulary", "1h")
+	articles := mustNewProfile(t, userID, "articles", "1w")
+	userIntervals := mustParseIntervals(t, "2d")
+
+	tagProfiles := map[string]Profile{
+		"words":    *vocabulary,
+		"articles": *articles,
+	}
+
+	tests := []struct {
+		name          string
+		itemProfile   *Profile
+		tags          valueobject.Tags
+		userIntervals valueobject.ReviewInterval
+		want          valueobject.ReviewInterval
+	}{
+		{
+			name:          "With item profile",
+			itemProfile:   itemProfile,
+			tags:          valueobject.NewTags("words"),
+			userIntervals: userIntervals,
+			want:          itemProfile.Intervals(),
+		},
+		{
+			name:          "With tag profile",
+			tags:          valueobject.NewTags("go", "words"),
+			userIntervals: userIntervals,
+			want:          vocabulary.Intervals(),
+		},
+		{
+			name:          "With several tag profiles",
+			tags:          valueobject.NewTags("words", "articles"),
+			userIntervals: userIntervals,
+			want:          articles.Intervals(),
+		},
+		{
+			name:          "With user intervals",
+			tags:          valueobject.NewTags("go"),
+			userIntervals: userIntervals,
+			want:          userIntervals,
+		},
+		{
+			name: "With nothing set",
+			want: valueobject.DefaultReviewIntervals(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Resolve(tt.itemProfile, tagProfiles, tt.tags, tt.userIntervals)
+
+			t.Run("Expect resolved intervals", func(t *testing.T) {
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestResolver_ResolveReviewIntervals(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.Must(uuid.NewV7())
+	ownProfile := mustNewProfile(t, userID, "own", "1m")
+	foreignProfile := mustNewProfile(t, uuid.Must(uuid.NewV7()), "foreign", "1y")
+	userIntervals := mustParseIntervals(t, "2d")
+
+	resolver := NewResolver(
+		stubProfiles{profiles: []*Profile{ownProfile, foreignProfile}},
+		stubUsers{intervals: userIntervals},
+	)
+
+	t.Run("Expect own item profile to be applied", func(t *testing.T) {
+		got, err := resolver.ResolveReviewIntervals(context.Background(), userID, ownProfile.ID(), valueobject.Tags{})
+		require.NoError(t, err)
+		assert.Equal(t, ownProfile.Intervals(), got)
+	})
+	t.Run("Expect foreign item profile to be ignored", func(t *testing.T) {
+		got, err := resolver.ResolveReviewIntervals(context.Background(), userID, foreignProfile.ID(), valueobject.Tags{})
+		require.NoError(t, err)
+		assert.Equal(t, userIntervals, got)
+	})
+}
+
+type stubProfiles struct {
+	profiles []*Profile
+}
+
+func (s stubProfiles) GetIntervalProfile(_ context.Context, id uuid.UUID) (*Profile, error) {
+	for _, p := range s.profiles {
+		if p.ID() == id {
+			return p, nil
+		}
+	}
+	return nil, assert.AnError
+}
+
+func (s stubProfiles) GetTagIntervalProfiles(context.Context, uuid.UUID) (map[string]Profile, error) {
+	return nil, nil
+}
+
+type stubUsers struct {
+	intervals valueobject.ReviewInterval
+}
+
+func (s stubUsers) GetUserReviewIntervals(context.Context, uuid.UUID) (valueobject.ReviewInterval, error) {
+	return s.intervals, nil
+}
+
+func mustNewProfile(t *testing.T, userID uuid.UUID, name, intervals string) *Profile {
+	t.Helper()
+
+	p, err := NewProfile(NewProfileArgs{
+		ID:        NewProfileID(),
+		UserID:    userID,
+		Name:      name,
+		Intervals: mustParseIntervals(t, intervals),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
@@ -0,0 +1,153 @@
+// Package outbox keeps the reminder deliveries of the users, one per reminder slot and channel,
+// so the failed ones are retried and the sent ones are not sent again.
+//
+// The deliveries are at least once: a delivery is marked sent only after its channel accepted it,
+// so a crash or a failed update in between sends it again on the next attempt. The receivers of
+// the webhook and email channels may get the same reminder twice and should not rely on its uniqueness.
+package outbox
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+)
+
+// MaxAttempts is how many times a notification is tried before the delivery is given up.
+const MaxAttempts = 5
+
+// maxErrorLength limits the stored error, telegram errors may contain the whole response.
+const maxErrorLength = 1024
+
+// Status is the state of a delivery.
+type Status string
+
+const (
+	// StatusPending deliveries are waiting for the next attempt.
+	StatusPending Status = "pending"
+	// StatusSent deliveries were sent to the user.
+	StatusSent Status = "sent"
+	// StatusSkipped deliveries had nothing due to be sent.
+	StatusSkipped Status = "skipped"
+	// StatusFailed deliveries were given up after MaxAttempts.
+	StatusFailed Status = "failed"
+)
+
+// Delivery is a notification of a user for a reminder slot through one of the user's channels.
+// There is at most one delivery per user, slot and channel, so a reminder is never sent twice,
+// and a failing channel is retried on its own.
+type Delivery struct {
+	id            uuid.UUID
+	userID        uuid.UUID
+	channel       user.Channel
+	reminderAt    time.Time
+	status        Status
+	attempts      int
+	lastError     string
+	nextAttemptAt time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+	sentAt        time.Time
+}
+
+// NewDelivery creates a pending delivery of the reminder through the channel, it is attempted right away.
+func NewDelivery(userID uuid.UUID, channel user.Channel, reminderAt, now time.Time) Delivery {
+	return Delivery{
+		id:            uuid.Must(uuid.NewV7()),
+		userID:        userID,
+		channel:       channel,
+		reminderAt:    reminderAt,
+		status:        StatusPending,
+		nextAttemptAt: now,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+}
+
+func (d *Delivery) ID() uuid.UUID {
+	return d.id
+}
+
+func (d *Delivery) UserID() uuid.UUID {
+	return d.userID
+}
+
+func (d *Delivery) Channel() user.Channel {
+	return d.channel
+}
+
+// ReminderAt returns the time of the reminder slot the delivery is for.
+func (d *Delivery) ReminderAt() time.Time {
+	return d.reminderAt
+}
+
+func (d *Delivery) Status() Status {
+	return d.status
+}
+
+func (d *Delivery) Attempts() int {
+	return d.attempts
+}
+
+// LastError returns the error of the last failed attempt, empty if there was none.
+func (d *Delivery) LastError() string {
+	return d.lastError
+}
+
+func (d *Delivery) NextAttemptAt() time.Time {
+	return d.nextAttemptAt
+}
+
+func (d *Delivery) CreatedAt() time.Time {
+	return d.createdAt
+}
+
+func (d *Delivery) UpdatedAt() time.Time {
+	return d.updatedAt
+}
+
+// SentAt returns when the delivery was sent, zero if it was not.
+func (d *Delivery) SentAt() time.Time {
+	return d.sentAt
+}
+
+// MarkSent marks the delivery as sent.
+func (d *Delivery) MarkSent(now time.Time) {
+	d.attempts++
+	d.status = StatusSent
+	d.sentAt = now
+	d.updatedAt = now
+}
+
+// MarkSkipped marks the delivery as skipped, when there was nothing to send.
+func (d *Delivery) MarkSkipped(now time.Time) {
+	d.status = StatusSkipped
+	d.updatedAt = now
+}
+
+// MarkFailed records the failed attempt and schedules the next one with an exponential backoff,
+// the delivery is given up after MaxAttempts.
+func (d *Delivery) MarkFailed(err error, now time.Time) {
+	d.attempts++
+	d.lastError = err.Error()
+	if len(d.lastError) > maxErrorLength {
+		d.lastError = d.lastError[:maxErrorLength]
+	}
+	d.updatedAt = now
+
+	if d.attempts >= MaxAttempts {
+		d.status = StatusFailed
+		return
+	}
+	d.nextAttemptAt = now.Add(Backoff(d.attempts))
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts:
+// 1m, 2m, 4m, 8m and so on.
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		return 0
+	}
+	return time.Minute << (attempts - 1)
+}
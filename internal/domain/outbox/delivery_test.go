@@ -0,0 +1,47 @@
+package outbox
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+)
+
+func TestDelivery_MarkFailed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC)
+	d := NewDelivery(uuid.Must(uuid.NewV7()), user.ChannelTelegram, now, now)
+
+	for attempt := 1; attempt < MaxAttempts; attempt++ {
+		d.MarkFailed(errors.New("telegram is down"), now)
+
+		assert.Equal(t, StatusPending, d.Status())
+		assert.Equal(t, attempt, d.Attempts())
+		assert.Equal(t, now.Add(Backoff(attempt)), d.NextAttemptAt())
+	}
+
+	d.MarkFailed(errors.New(strings.Repeat("x", 2*maxErrorLength)), now)
+
+	t.Run("Expect the delivery to be given up", func(t *testing.T) {
+		assert.Equal(t, StatusFailed, d.Status())
+		assert.Equal(t, MaxAttempts, d.Attempts())
+	})
+	t.Run("Expect the error to be truncated", func(t *testing.T) {
+		assert.Len(t, d.LastError(), maxErrorLength)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, time.Duration(0), Backoff(0))
+	assert.Equal(t, time.Minute, Backoff(1))
+	assert.Equal(t, 2*time.Minute, Backoff(2))
+	assert.Equal(t, 8*time.Minute, Backoff(4))
+}
@@ -0,0 +1,21 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/gofrs/uuid"
+)
+
+type Repository interface {
+	// Enqueue adds the deliveries of a reminder, one per channel of the user, to the outbox
+	// and moves the last notified at watermark of the user to the reminder.
+	// It returns false if the user was already notified for this or a later reminder.
+	Enqueue(ctx context.Context, deliveries []Delivery) (bool, error)
+	// ListPending returns up to limit pending deliveries due for an attempt at the given time, the oldest first.
+	ListPending(ctx context.Context, now time.Time, limit int) ([]Delivery, error)
+	// Update updates the state of the delivery after an attempt.
+	Update(ctx context.Context, delivery Delivery) error
+	// Get returns the delivery by id.
+	Get(ctx context.Context, id uuid.UUID) (Delivery, error)
+}
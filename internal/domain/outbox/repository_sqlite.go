@@ -0,0 +1,202 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
+	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/pkg/logutil"
+)
+
+type SQLiteRepo struct {
+	db *sql.DB
+}
+
+func NewSQLiteRepo(db *sql.DB) SQLiteRepo {
+	return SQLiteRepo{db: db}
+}
+
+func (r *SQLiteRepo) withTx(ctx context.Context, op errs.Op, fn func(*sqlc.Queries) error) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return sqliterr.HandleTx(op, err, "failed to begin transaction")
+	}
+
+	defer func() {
+		if err != nil {
+			if rollbackErr := tx.Rollback(); rollbackErr != nil {
+				slog.
+					With(slog.String("op", string(op))).
+					Error("failed to rollback transaction",
+						logutil.Err(rollbackErr),
+						"original_error", err)
+			}
+		}
+	}()
+
+	qtx := sqlc.New(tx)
+	if err = fn(qtx); err != nil {
+		return err // Already wrapped with operation
+	}
+
+	if err = tx.Commit(); err != nil {
+		return sqliterr.HandleTx(op, err, "failed to commit transaction")
+	}
+
+	return nil
+}
+
+// Enqueue adds the deliveries of a reminder, one per channel of the user, to the outbox
+// and moves the last notified at watermark of the user to the reminder.
+// All the deliveries must be of the same user and reminder.
+// It returns false if the user was already notified for this or a later reminder.
+func (r *SQLiteRepo) Enqueue(ctx context.Context, deliveries []Delivery) (bool, error) {
+	op := errs.Op("domain.outbox.sqlite.enqueue")
+	if len(deliveries) == 0 {
+		return false, nil
+	}
+	userID, reminderAt := deliveries[0].userID, deliveries[0].reminderAt
+	for _, d := range deliveries {
+		if d.userID != userID || !d.reminderAt.Equal(reminderAt) {
+			return false, errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "deliveries of different reminders").
+				WithContext("user_id", userID).
+				WithContext("reminder_at", reminderAt)
+		}
+	}
+
+	var enqueued bool
+	err := r.withTx(ctx, op, func(q *sqlc.Queries) error {
+		claimed, err := q.ClaimUserNotification(ctx, sqlc.ClaimUserNotificationParams{
+			NotifiedAt: sql.NullTime{Time: reminderAt.UTC(), Valid: true},
+			ID:         userID.String(),
+		})
+		if err != nil {
+			return sqliterr.
+				Handle(op, err, "failed to claim user notification").
+				WithContext("user_id", userID).
+				WithContext("reminder_at", reminderAt)
+		}
+		if claimed == 0 {
+			return nil
+		}
+
+		for _, d := range deliveries {
+			created, err := q.CreateDelivery(ctx, deliveryToCreateParams(d))
+			if err != nil {
+				return sqliterr.
+					Handle(op, err, "failed to create delivery").
+					WithContext("user_id", userID).
+					WithContext("channel", d.channel).
+					WithContext("reminder_at", reminderAt)
+			}
+			enqueued = enqueued || created > 0
+		}
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return enqueued, nil
+}
+
+// ListPending returns up to limit pending deliveries due for an attempt at the given time, the oldest first.
+func (r *SQLiteRepo) ListPending(ctx context.Context, now time.Time, limit int) ([]Delivery, error) {
+	op := errs.Op("domain.outbox.sqlite.list_pending")
+	q := sqlc.New(r.db)
+
+	models, err := q.ListPendingDeliveries(ctx, sqlc.ListPendingDeliveriesParams{
+		NextAttemptAt: now.UTC(),
+		Limit:         int64(limit),
+	})
+	if err != nil {
+		return nil, sqliterr.Handle(op, err, "failed to list pending deliveries")
+	}
+
+	deliveries := make([]Delivery, 0, len(models))
+	for _, model := range models {
+		deliveries = append(deliveries, modelToDelivery(model))
+	}
+	return deliveries, nil
+}
+
+// Update updates the state of the delivery after an attempt.
+func (r *SQLiteRepo) Update(ctx context.Context, d Delivery) error {
+	op := errs.Op("domain.outbox.sqlite.update")
+	q := sqlc.New(r.db)
+
+	err := q.UpdateDelivery(ctx, sqlc.UpdateDeliveryParams{
+		Status:        string(d.status),
+		Attempts:      int64(d.attempts),
+		LastError:     sql.NullString{String: d.lastError, Valid: d.lastError != ""},
+		NextAttemptAt: d.nextAttemptAt.UTC(),
+		UpdatedAt:     d.updatedAt.UTC(),
+		SentAt:        timeToNullTime(d.sentAt),
+		ID:            d.id.String(),
+	})
+	if err != nil {
+		return sqliterr.Handle(op, err, "failed to update delivery").WithContext("id", d.id)
+	}
+	return nil
+}
+
+// Get returns the delivery by id.
+func (r *SQLiteRepo) Get(ctx context.Context, id uuid.UUID) (Delivery, error) {
+	op := errs.Op("domain.outbox.sqlite.get")
+	q := sqlc.New(r.db)
+
+	model, err := q.GetDelivery(ctx, id.String())
+	if err != nil {
+		return Delivery{}, sqliterr.Handle(op, err, "failed to get delivery").WithContext("id", id)
+	}
+	return modelToDelivery(model), nil
+}
+
+func deliveryToCreateParams(d Delivery) sqlc.CreateDeliveryParams {
+	return sqlc.CreateDeliveryParams{
+		ID:            d.id.String(),
+		UserID:        d.userID.String(),
+		Channel:       string(d.channel),
+		ReminderAt:    d.reminderAt.UTC(),
+		Status:        string(d.status),
+		Attempts:      int64(d.attempts),
+		LastError:     sql.NullString{String: d.lastError, Valid: d.lastError != ""},
+		NextAttemptAt: d.nextAttemptAt.UTC(),
+		CreatedAt:     d.createdAt.UTC(),
+		UpdatedAt:     d.updatedAt.UTC(),
+		SentAt:        timeToNullTime(d.sentAt),
+	}
+}
+
+func modelToDelivery(m sqlc.NotificationOutbox) Delivery {
+	d := Delivery{
+		id:            uuid.FromStringOrNil(m.ID),
+		userID:        uuid.FromStringOrNil(m.UserID),
+		channel:       user.Channel(m.Channel),
+		reminderAt:    m.ReminderAt,
+		status:        Status(m.Status),
+		attempts:      int(m.Attempts),
+		lastError:     m.LastError.String,
+		nextAttemptAt: m.NextAttemptAt,
+		createdAt:     m.CreatedAt,
+		updatedAt:     m.UpdatedAt,
+	}
+	if m.SentAt.Valid {
+		d.sentAt = m.SentAt.Time
+	}
+	return d
+}
+
+func timeToNullTime(t time.Time) sql.NullTime {
+	if t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: t.UTC(), Valid: true}
+}
@@ -1,12 +1,20 @@
 package reviseitem
 
 import (
+	"time"
+
 	"github.com/ARUMANDESU/go-revise/internal/domain/revision"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
 // Aggregate represents a revise item aggregate.
 type Aggregate struct {
 	ReviseItem
+	// revisionCount is the number of revisions already persisted for the item.
+	revisionCount int
+	// revisions are the revisions recorded since the aggregate was loaded.
 	revisions []revision.Revision
 }
 
@@ -14,10 +22,101 @@ func NewAggregate(item *ReviseItem) *Aggregate {
 	return &Aggregate{ReviseItem: *item}
 }
 
-func (a *Aggregate) Review() {
-	a.revisions = append(a.revisions, *revision.NewRevision())
+// NewAggregateWithRevisionCount returns an aggregate of an item that already has
+// revisionCount persisted revisions.
+func NewAggregateWithRevisionCount(item *ReviseItem, revisionCount int) *Aggregate {
+	return &Aggregate{ReviseItem: *item, revisionCount: revisionCount}
+}
+
+// Review records a revision with the given grade and journal entries and moves
+// the next revision to the time decided by the scheduler.
+func (a *Aggregate) Review(s scheduler.Scheduler, args revision.NewRevisionArgs) error {
+	op := errs.Op("domain.reviseitem.aggregate.review")
+	args.Kind = revision.KindReview
+	rev, err := revision.NewRevision(args)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create revision")
+	}
+
+	a.record(rev, s.Schedule(a.scheduledMemory(), rev.Grade(), rev.RevisedAt()))
+	return nil
+}
+
+// Relapse records a revision of a forgotten item and moves it the given number
+// of steps back, the grade defaults to again.
+func (a *Aggregate) Relapse(s scheduler.Scheduler, steps int, args revision.NewRevisionArgs) error {
+	op := errs.Op("domain.reviseitem.aggregate.relapse")
+	if steps < 1 {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "steps must be positive").
+			WithMessages([]errs.Message{{Key: "message", Value: "relapse must move the item at least one step back"}}).
+			WithContext("steps", steps)
+	}
+
+	args.Kind = revision.KindRelapse
+	if args.Grade == 0 {
+		args.Grade = valueobject.GradeAgain
+	}
+	rev, err := revision.NewRevision(args)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create revision")
+	}
+
+	a.record(rev, scheduler.Relapse(s, a.scheduledMemory(), steps, rev.RevisedAt()))
+	return nil
+}
+
+// ResetProgress restarts the progress of the item, the next revision is due after
+// the first interval. The revision history is kept and the reset is recorded in it.
+func (a *Aggregate) ResetProgress(firstInterval time.Duration) error {
+	op := errs.Op("domain.reviseitem.aggregate.reset_progress")
+	if firstInterval <= 0 {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "first interval must be positive").
+			WithContext("first_interval", firstInterval)
+	}
+
+	rev, err := revision.NewRevision(revision.NewRevisionArgs{Kind: revision.KindReset})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create revision")
+	}
+
+	// the interval is kept so the reset item is not taken for one revised before grade-based scheduling
+	a.memory = scheduler.Memory{Interval: firstInterval}
+	a.nextRevisionAt = rev.RevisedAt().Add(firstInterval)
+	a.updatedAt = time.Now()
+	a.revisions = append(a.revisions, *rev)
+	return nil
+}
+
+// scheduledMemory returns the memory state to schedule from.
+// Items revised before grade-based scheduling have no memory state, their position
+// is taken from the number of prior revisions and their interval from the default ladder
+// they were scheduled by, so the interval based algorithms like SM-2 have one to grow.
+func (a *Aggregate) scheduledMemory() scheduler.Memory {
+	memory := a.Memory()
+	if memory.Interval == 0 && memory.Repetitions == 0 && a.revisionCount > 0 {
+		memory.Repetitions = a.revisionCount
+		memory.Interval = valueobject.DefaultReviewIntervals().At(a.revisionCount - 1)
+	}
+	return memory
 }
 
+// record appends the revision and moves the next revision by the new memory state.
+func (a *Aggregate) record(rev *revision.Revision, memory scheduler.Memory) {
+	a.memory = memory
+	a.lastRevisedAt = rev.RevisedAt()
+	a.nextRevisionAt = rev.RevisedAt().Add(a.memory.Interval)
+	a.updatedAt = time.Now()
+	a.revisions = append(a.revisions, *rev)
+}
+
+// Revisions returns the revisions recorded since the aggregate was loaded.
 func (a *Aggregate) Revisions() []revision.Revision {
 	return a.revisions
 }
+
+// RevisionCount returns the total number of revisions of the item.
+func (a *Aggregate) RevisionCount() int {
+	return a.revisionCount + len(a.revisions)
+}
@@ -0,0 +1,242 @@
+package reviseitem
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/clarify/subtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/revision"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestAggregate_Review(t *testing.T) {
+	t.Parallel()
+
+	intervals := valueobject.DefaultReviewIntervals()
+
+	tests := []struct {
+		name          string
+		item          ReviseItem
+		revisionCount int
+		grade         valueobject.Grade
+		notes         string
+		duration      time.Duration
+		confidence    valueobject.Confidence
+		wantInterval  time.Duration
+		errExpected   bool
+	}{
+		{
+			name:         "With new item",
+			item:         ReviseItem{},
+			grade:        valueobject.GradeGood,
+			wantInterval: intervals.At(1),
+		},
+		{
+			name:          "With revisions made before grade-based scheduling",
+			item:          ReviseItem{},
+			revisionCount: 2,
+			grade:         valueobject.GradeGood,
+			wantInterval:  intervals.At(3),
+		},
+		{
+			name: "With scheduled item",
+			item: ReviseItem{
+				memory: scheduler.Memory{Repetitions: 1, Interval: intervals.At(1)},
+			},
+			revisionCount: 5,
+			grade:         valueobject.GradeGood,
+			wantInterval:  intervals.At(2),
+		},
+		{
+			name:          "With again grade",
+			item:          ReviseItem{},
+			revisionCount: 4,
+			grade:         valueobject.GradeAgain,
+			wantInterval:  intervals.At(0),
+		},
+		{
+			name:         "With journal entries",
+			item:         ReviseItem{},
+			grade:        valueobject.GradeHard,
+			notes:        "  mixed up the formulas  ",
+			duration:     5 * time.Minute,
+			confidence:   2,
+			wantInterval: intervals.At(0),
+		},
+		{
+			name:        "With invalid confidence",
+			item:        ReviseItem{},
+			grade:       valueobject.GradeGood,
+			confidence:  6,
+			errExpected: true,
+		},
+		{
+			name:        "With negative duration",
+			item:        ReviseItem{},
+			grade:       valueobject.GradeGood,
+			duration:    -time.Minute,
+			errExpected: true,
+		},
+		{
+			name:        "With invalid grade",
+			item:        ReviseItem{},
+			grade:       valueobject.Grade(0),
+			errExpected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregate := NewAggregateWithRevisionCount(&tt.item, tt.revisionCount)
+
+			err := aggregate.Review(scheduler.NewLadder(intervals), revision.NewRevisionArgs{
+				Grade:      tt.grade,
+				Notes:      tt.notes,
+				Duration:   tt.duration,
+				Confidence: tt.confidence,
+			})
+			if tt.errExpected {
+				t.Run("Expect error", subtest.Value(err).Error())
+				t.Run("Expect no revision recorded", subtest.Value(aggregate.RevisionCount()).DeepEqual(tt.revisionCount))
+				return
+			}
+
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect revision recorded", subtest.Value(len(aggregate.Revisions())).DeepEqual(1))
+			t.Run("Expect revision count incremented", subtest.Value(aggregate.RevisionCount()).DeepEqual(tt.revisionCount+1))
+			t.Run("Expect next revision moved", func(t *testing.T) {
+				assert.WithinDuration(t, time.Now().Add(tt.wantInterval), aggregate.NextRevisionAt(), time.Second)
+				assert.Equal(t, aggregate.Revisions()[0].RevisedAt(), aggregate.LastRevisedAt())
+			})
+			t.Run("Expect journal recorded", func(t *testing.T) {
+				rev := aggregate.Revisions()[0]
+				assert.Equal(t, tt.grade, rev.Grade())
+				assert.Equal(t, strings.TrimSpace(tt.notes), rev.Notes())
+				assert.Equal(t, tt.duration, rev.Duration())
+				assert.Equal(t, tt.confidence, rev.Confidence())
+			})
+		})
+	}
+}
+
+func TestAggregate_Review_SM2(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Expect item revised before grade-based scheduling to grow from its ladder interval", func(t *testing.T) {
+		// the third revision of the default ladder was a week after the second one
+		aggregate := NewAggregateWithRevisionCount(&ReviseItem{}, 3)
+
+		err := aggregate.Review(scheduler.NewSM2(valueobject.ReviewInterval{}), revision.NewRevisionArgs{Grade: valueobject.GradeGood})
+		require.NoError(t, err)
+
+		// round(7 days * 2.5 ease)
+		assert.Equal(t, 18*24*time.Hour, aggregate.Memory().Interval)
+		assert.WithinDuration(t, time.Now().Add(18*24*time.Hour), aggregate.NextRevisionAt(), time.Second)
+		assert.Equal(t, 4, aggregate.Memory().Repetitions)
+	})
+}
+
+func TestAggregate_Relapse(t *testing.T) {
+	t.Parallel()
+
+	intervals := valueobject.DefaultReviewIntervals()
+
+	tests := []struct {
+		name          string
+		item          ReviseItem
+		revisionCount int
+		steps         int
+		grade         valueobject.Grade
+		wantInterval  time.Duration
+		wantGrade     valueobject.Grade
+		errExpected   bool
+	}{
+		{
+			name: "With one step back",
+			item: ReviseItem{
+				memory: scheduler.Memory{Repetitions: 3, Interval: intervals.At(3)},
+			},
+			steps:        1,
+			wantInterval: intervals.At(2),
+			wantGrade:    valueobject.GradeAgain,
+		},
+		{
+			name:          "With revisions made before grade-based scheduling",
+			item:          ReviseItem{},
+			revisionCount: 4,
+			steps:         2,
+			grade:         valueobject.GradeHard,
+			wantInterval:  intervals.At(2),
+			wantGrade:     valueobject.GradeHard,
+		},
+		{
+			name:        "With zero steps",
+			item:        ReviseItem{},
+			steps:       0,
+			errExpected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			aggregate := NewAggregateWithRevisionCount(&tt.item, tt.revisionCount)
+
+			err := aggregate.Relapse(
+				scheduler.NewLadder(intervals),
+				tt.steps,
+				revision.NewRevisionArgs{Grade: tt.grade},
+			)
+			if tt.errExpected {
+				t.Run("Expect error", subtest.Value(err).Error())
+				return
+			}
+
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect next revision moved back", func(t *testing.T) {
+				assert.WithinDuration(t, time.Now().Add(tt.wantInterval), aggregate.NextRevisionAt(), time.Second)
+			})
+			t.Run("Expect lapse recorded", func(t *testing.T) {
+				rev := aggregate.Revisions()[0]
+				assert.Equal(t, revision.KindRelapse, rev.Kind())
+				assert.Equal(t, tt.wantGrade, rev.Grade())
+				assert.True(t, rev.IsLapse())
+			})
+		})
+	}
+}
+
+func TestAggregate_ResetProgress(t *testing.T) {
+	t.Parallel()
+
+	intervals := valueobject.DefaultReviewIntervals()
+	item := ReviseItem{
+		lastRevisedAt: time.Now().Add(-time.Hour),
+		memory:        scheduler.Memory{Repetitions: 5, Interval: intervals.At(5), Ease: 2.1},
+	}
+	aggregate := NewAggregateWithRevisionCount(&item, 5)
+
+	err := aggregate.ResetProgress(intervals.At(0))
+	t.Run("Expect no error", subtest.Value(err).NoError())
+	t.Run("Expect next revision after the first interval", func(t *testing.T) {
+		assert.WithinDuration(t, time.Now().Add(intervals.At(0)), aggregate.NextRevisionAt(), time.Second)
+	})
+	t.Run("Expect reset recorded", func(t *testing.T) {
+		assert.Equal(t, 6, aggregate.RevisionCount())
+		assert.Equal(t, revision.KindReset, aggregate.Revisions()[0].Kind())
+		assert.True(t, aggregate.Revisions()[0].IsLapse())
+	})
+
+	err = aggregate.Review(scheduler.NewLadder(intervals), revision.NewRevisionArgs{Grade: valueobject.GradeGood})
+	t.Run("Expect no error on review", subtest.Value(err).NoError())
+	t.Run("Expect ladder restarted", func(t *testing.T) {
+		assert.WithinDuration(t, time.Now().Add(intervals.At(1)), aggregate.NextRevisionAt(), time.Second)
+	})
+
+	err = aggregate.ResetProgress(0)
+	t.Run("Expect error on zero interval", subtest.Value(err).Error())
+}
@@ -13,4 +13,6 @@ type Repository interface {
 	Save(ctx context.Context, item Aggregate) error
 	// Update updates a revise item.
 	Update(ctx context.Context, id uuid.UUID, fn UpdateFn) error
+	// UpdateDeleted updates a deleted revise item, e.g. to restore it.
+	UpdateDeleted(ctx context.Context, id uuid.UUID, fn UpdateFn) error
 }
@@ -3,7 +3,9 @@ package reviseitem
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"log/slog"
+	"sort"
 	"strings"
 	"time"
 
@@ -12,6 +14,8 @@ import (
 	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
 	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
 	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/revision"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
 	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 	"github.com/ARUMANDESU/go-revise/pkg/logutil"
@@ -31,15 +35,22 @@ func (r *SQLiteRepo) Save(ctx context.Context, item Aggregate) (_ error) {
 	op := errs.Op("domain.reviseitem.sqlite.save")
 	tags := item.Tags()
 	args := sqlc.SaveReviseItemParams{
-		ID:             item.id.String(),
-		UserID:         item.userID.String(),
-		Name:           item.name,
-		Description:    sql.NullString{String: item.description, Valid: item.description != ""},
-		Tags:           stringArrToString(tags.StringArray()),
-		CreatedAt:      item.createdAt,
-		UpdatedAt:      item.updatedAt,
-		LastRevisedAt:  item.lastRevisedAt,
-		NextRevisionAt: item.nextRevisionAt,
+		ID:                item.id.String(),
+		UserID:            item.userID.String(),
+		Name:              item.name,
+		Description:       sql.NullString{String: item.description, Valid: item.description != ""},
+		Tags:              stringArrToString(tags.StringArray()),
+		CreatedAt:         item.createdAt,
+		UpdatedAt:         item.updatedAt,
+		LastRevisedAt:     item.lastRevisedAt.UTC(),
+		NextRevisionAt:    item.nextRevisionAt.UTC(),
+		Ease:              item.memory.Ease,
+		Stability:         item.memory.Stability,
+		Difficulty:        item.memory.Difficulty,
+		Repetitions:       int64(item.memory.Repetitions),
+		IntervalSeconds:   int64(item.memory.Interval.Seconds()),
+		IntervalProfileID: uuidToNullString(item.intervalProfileID),
+		Source:            sql.NullString{String: item.source, Valid: item.source != ""},
 	}
 
 	q := sqlc.New(r.db)
@@ -84,10 +95,23 @@ func (r *SQLiteRepo) withTx(ctx context.Context, op errs.Op, fn func(*sqlc.Queri
 
 // Update updates a revise item.
 func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) (err error) {
-	op := errs.Op("domain.reviseitem.sqlite.update")
+	return r.update(ctx, errs.Op("domain.reviseitem.sqlite.update"), id, (*sqlc.Queries).GetReviseItem, fn)
+}
+
+// UpdateDeleted updates a deleted revise item, e.g. to restore it.
+func (r *SQLiteRepo) UpdateDeleted(ctx context.Context, id uuid.UUID, fn UpdateFn) error {
+	return r.update(ctx, errs.Op("domain.reviseitem.sqlite.update_deleted"), id, (*sqlc.Queries).GetDeletedReviseItem, fn)
+}
 
+func (r *SQLiteRepo) update(
+	ctx context.Context,
+	op errs.Op,
+	id uuid.UUID,
+	get func(*sqlc.Queries, context.Context, string) (sqlc.ReviseItem, error),
+	fn UpdateFn,
+) error {
 	return r.withTx(ctx, op, func(q *sqlc.Queries) error {
-		reviseItemModel, err := q.GetReviseItem(ctx, id.String())
+		reviseItemModel, err := get(q, ctx, id.String())
 		if err != nil {
 			return sqliterr.Handle(op, err, "failed to get revise item").WithContext("id", id)
 		}
@@ -97,7 +121,12 @@ func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) (err
 			return errs.WithOp(op, err, "failed to convert model to revise item")
 		}
 
-		aggregate := NewAggregate(&reviseItem)
+		revisionCount, err := q.CountReviseItemRevisions(ctx, id.String())
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to count revisions").WithContext("id", id)
+		}
+
+		aggregate := NewAggregateWithRevisionCount(&reviseItem, int(revisionCount))
 
 		aggregate, err = fn(aggregate)
 		if err != nil {
@@ -108,7 +137,15 @@ func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) (err
 			args := sqlc.CreateRevisionParams{
 				ID:           r.ID().String(),
 				ReviseItemID: aggregate.ID().String(),
-				RevisedAt:    r.RevisedAt(),
+				RevisedAt:    r.RevisedAt().UTC(),
+				Grade:        int64(r.Grade()),
+				Notes: sql.NullString{
+					String: r.Notes(),
+					Valid:  r.Notes() != "",
+				},
+				DurationSeconds: int64(r.Duration().Seconds()),
+				Confidence:      int64(r.Confidence()),
+				Kind:            string(r.Kind()),
 			}
 
 			err = q.CreateRevision(ctx, args)
@@ -120,18 +157,27 @@ func (r *SQLiteRepo) Update(ctx context.Context, id uuid.UUID, fn UpdateFn) (err
 		}
 
 		tags := aggregate.Tags()
+		memory := aggregate.Memory()
 		err = q.UpdateReviseItem(ctx, sqlc.UpdateReviseItemParams{
 			Name: aggregate.Name(),
 			Description: sql.NullString{
 				String: aggregate.Description(),
 				Valid:  aggregate.Description() != "",
 			},
-			Tags:           stringArrToString(tags.StringArray()),
-			CreatedAt:      aggregate.CreatedAt(),
-			UpdatedAt:      aggregate.UpdatedAt(),
-			LastRevisedAt:  aggregate.LastRevisedAt(),
-			NextRevisionAt: aggregate.NextRevisionAt(),
-			ID:             aggregate.ID().String(),
+			Tags:              stringArrToString(tags.StringArray()),
+			CreatedAt:         aggregate.CreatedAt(),
+			UpdatedAt:         aggregate.UpdatedAt(),
+			LastRevisedAt:     aggregate.LastRevisedAt().UTC(),
+			NextRevisionAt:    aggregate.NextRevisionAt().UTC(),
+			Ease:              memory.Ease,
+			Stability:         memory.Stability,
+			Difficulty:        memory.Difficulty,
+			Repetitions:       int64(memory.Repetitions),
+			IntervalSeconds:   int64(memory.Interval.Seconds()),
+			IntervalProfileID: uuidToNullString(aggregate.IntervalProfileID()),
+			Source:            sql.NullString{String: aggregate.Source(), Valid: aggregate.Source() != ""},
+			DeletedAt:         timeToNullTime(aggregate.DeletedAt()),
+			ID:                aggregate.ID().String(),
 		})
 		if err != nil {
 			return sqliterr.
@@ -186,6 +232,9 @@ func (r *SQLiteRepo) ListUserReviseItems(
 			NextRevisionAt: item.NextRevisionAt,
 			LastRevisedAt:  item.LastRevisedAt,
 			Revisions:      nil,
+
+			IntervalProfileID: uuid.FromStringOrNil(item.IntervalProfileID.String),
+			Source:            item.Source.String,
 		}
 
 		revisions, err := r.getRevisions(ctx, q, item.ID)
@@ -207,7 +256,7 @@ func (r *SQLiteRepo) getRevisions(
 	ctx context.Context,
 	q *sqlc.Queries,
 	reviseItemID string,
-) ([]time.Time, error) {
+) ([]query.Revision, error) {
 	op := errs.Op("domain.reviseitem.sqlite.get_revisions")
 	revisionModels, err := q.GetRevisionItemRevisions(ctx, reviseItemID)
 	if err != nil {
@@ -215,9 +264,9 @@ func (r *SQLiteRepo) getRevisions(
 			Handle(op, err, "failed to get revision item revisions").
 			WithContext("id", reviseItemID)
 	}
-	revisions := make([]time.Time, 0, len(revisionModels))
+	revisions := make([]query.Revision, 0, len(revisionModels))
 	for _, revision := range revisionModels {
-		revisions = append(revisions, revision.RevisedAt)
+		revisions = append(revisions, modelToRevision(revision))
 	}
 	return revisions, nil
 }
@@ -238,34 +287,20 @@ func (r *SQLiteRepo) GetReviseItem(
 			WithContext("id", id)
 	}
 
-	var deletedAt *time.Time
-	if reviseItemModel.DeletedAt.Valid {
-		deletedAt = pointers.New(reviseItemModel.DeletedAt.Time)
-	}
-	reviseItem := query.ReviseItem{
-		ID:             uuid.FromStringOrNil(reviseItemModel.ID),
-		UserID:         uuid.FromStringOrNil(reviseItemModel.UserID),
-		Name:           reviseItemModel.Name,
-		Description:    reviseItemModel.Description.String,
-		Tags:           valueobject.NewTags(stringToStringArr(reviseItemModel.Tags)...),
-		CreatedAt:      reviseItemModel.CreatedAt,
-		UpdatedAt:      reviseItemModel.UpdatedAt,
-		DeletedAt:      deletedAt,
-		NextRevisionAt: reviseItemModel.NextRevisionAt,
-		LastRevisedAt:  reviseItemModel.LastRevisedAt,
-		Revisions:      nil,
+	// the ids of the items get shared, the items of the other users must not be seen by them
+	if reviseItemModel.UserID != userID.String() {
+		return query.ReviseItem{}, errs.
+			NewNotFound(op, nil, "revise item not found").
+			WithMessages([]errs.Message{{Key: "message", Value: "requested record was not found"}}).
+			WithContext("id", id).
+			WithContext("user_id", userID)
 	}
 
-	// get revisionModels
-	revisionModels, err := q.GetRevisionItemRevisions(ctx, id.String())
+	reviseItem := modelToQueryReviseItem(reviseItemModel)
+
+	revisions, err := r.getRevisions(ctx, q, id.String())
 	if err != nil {
-		return query.ReviseItem{}, sqliterr.
-			Handle(op, err, "failed to get revise item revisions").
-			WithContext("id", id)
-	}
-	revisions := make([]time.Time, 0, len(revisionModels))
-	for _, revision := range revisionModels {
-		revisions = append(revisions, revision.RevisedAt)
+		return query.ReviseItem{}, errs.WithOp(op, err, "failed to get revise item revisions")
 	}
 
 	reviseItem.Revisions = revisions
@@ -273,17 +308,160 @@ func (r *SQLiteRepo) GetReviseItem(
 	return reviseItem, nil
 }
 
+// SearchUserReviseItems returns the items of the user whose name, description or tags contain the text,
+// the items matching by name first. The revisions of the items are not loaded.
+func (r *SQLiteRepo) SearchUserReviseItems(
+	ctx context.Context,
+	userID uuid.UUID,
+	text string,
+	pagination valueobject.Pagination,
+) ([]query.ReviseItem, valueobject.PaginationMetadata, error) {
+	op := errs.Op("domain.reviseitem.sqlite.search_user_revise_items")
+	q := sqlc.New(r.db)
+
+	rows, err := q.SearchUserReviseItems(ctx, sqlc.SearchUserReviseItemsParams{
+		UserID:  userID.String(),
+		Pattern: "%" + likeEscaper.Replace(strings.ToLower(text)) + "%",
+		Limit:   int64(pagination.Limit()),
+		Offset:  int64(pagination.Offset()),
+	})
+	if err != nil {
+		return nil, valueobject.PaginationMetadata{}, sqliterr.
+			Handle(op, err, "failed to search user revise items").
+			WithContext("user_id", userID)
+	}
+
+	var totalCount int
+	items := make([]query.ReviseItem, 0, len(rows))
+	for _, row := range rows {
+		totalCount = int(row.Count)
+		items = append(items, modelToQueryReviseItem(sqlc.ReviseItem{
+			ID:                row.ID,
+			UserID:            row.UserID,
+			Name:              row.Name,
+			Description:       row.Description,
+			Tags:              row.Tags,
+			CreatedAt:         row.CreatedAt,
+			UpdatedAt:         row.UpdatedAt,
+			DeletedAt:         row.DeletedAt,
+			LastRevisedAt:     row.LastRevisedAt,
+			NextRevisionAt:    row.NextRevisionAt,
+			Ease:              row.Ease,
+			Stability:         row.Stability,
+			Difficulty:        row.Difficulty,
+			Repetitions:       row.Repetitions,
+			IntervalSeconds:   row.IntervalSeconds,
+			IntervalProfileID: row.IntervalProfileID,
+			Source:            row.Source,
+		}))
+	}
+
+	return items, pagination.Metadata(totalCount), nil
+}
+
+// ListUserTags returns the tags of the revise items of the user, the most used first.
+func (r *SQLiteRepo) ListUserTags(ctx context.Context, userID uuid.UUID) ([]string, error) {
+	op := errs.Op("domain.reviseitem.sqlite.list_user_tags")
+	q := sqlc.New(r.db)
+
+	rows, err := q.ListUserReviseItemTags(ctx, userID.String())
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to list user revise item tags").
+			WithContext("user_id", userID)
+	}
+
+	usage := make(map[string]int)
+	for _, row := range rows {
+		for _, tag := range stringToStringArr(row) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				usage[tag]++
+			}
+		}
+	}
+
+	tags := make([]string, 0, len(usage))
+	for tag := range usage {
+		tags = append(tags, tag)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if usage[tags[i]] != usage[tags[j]] {
+			return usage[tags[i]] > usage[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	return tags, nil
+}
+
+// ListUserDueReviseItems returns the items of the user due until the given time.
+func (r *SQLiteRepo) ListUserDueReviseItems(
+	ctx context.Context,
+	userID uuid.UUID,
+	until time.Time,
+) ([]query.ReviseItem, error) {
+	op := errs.Op("domain.reviseitem.sqlite.list_user_due_revise_items")
+	q := sqlc.New(r.db)
+
+	models, err := q.GetUserReviseItemsByTime(ctx, sqlc.GetUserReviseItemsByTimeParams{
+		UserID:         userID.String(),
+		NextRevisionAt: until.UTC(),
+	})
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to get user revise items by time").
+			WithContext("user_id", userID)
+	}
+
+	items := make([]query.ReviseItem, 0, len(models))
+	for _, model := range models {
+		item := modelToQueryReviseItem(model)
+		item.Revisions, err = r.getRevisions(ctx, q, model.ID)
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to get revisions")
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// GetUserDailyUsage returns how many items the user went through since the given time.
+func (r *SQLiteRepo) GetUserDailyUsage(
+	ctx context.Context,
+	userID uuid.UUID,
+	since time.Time,
+) (valueobject.DailyUsage, error) {
+	op := errs.Op("domain.reviseitem.sqlite.get_user_daily_usage")
+	q := sqlc.New(r.db)
+
+	usage, err := q.GetUserDailyUsage(ctx, sqlc.GetUserDailyUsageParams{
+		UserID: userID.String(),
+		Since:  since.UTC(),
+	})
+	if err != nil {
+		return valueobject.DailyUsage{}, sqliterr.
+			Handle(op, err, "failed to get user daily usage").
+			WithContext("user_id", userID)
+	}
+
+	// the first revision of a new item is counted as a new item, not as a review
+	return valueobject.DailyUsage{
+		Reviews:  int(usage.Revisions - usage.NewItems),
+		NewItems: int(usage.NewItems),
+	}, nil
+}
+
+// FetchReviseItemsDueForUser returns the items of the user due before the given time.
 func (r *SQLiteRepo) FetchReviseItemsDueForUser(
 	ctx context.Context,
 	userID uuid.UUID,
+	until time.Time,
 ) ([]ReviseItem, error) {
 	op := errs.Op("domain.reviseitem.sqlite.fetch_revise_items_due_for_user")
 	q := sqlc.New(r.db)
 
-	dayStart := time.Now().Truncate(24 * time.Hour)
 	reviseItems, err := q.GetUserReviseItemsByTime(ctx, sqlc.GetUserReviseItemsByTimeParams{
 		UserID:         userID.String(),
-		NextRevisionAt: dayStart.Add(24 * time.Hour), // before end of day
+		NextRevisionAt: until.UTC(),
 	})
 	if err != nil {
 		return nil, sqliterr.
@@ -303,6 +481,48 @@ func (r *SQLiteRepo) FetchReviseItemsDueForUser(
 	return aggregates, nil
 }
 
+// GetUserDueCounts returns the number of items of the user due per local day from the given time,
+// the excluded item is not counted. The days are counted in the UTC offset of the time zone at the given time,
+// the days after a daylight saving time change are off by its hour.
+func (r *SQLiteRepo) GetUserDueCounts(
+	ctx context.Context,
+	userID uuid.UUID,
+	from time.Time,
+	excludeID uuid.UUID,
+	tz valueobject.Timezone,
+) (scheduler.DueCounts, error) {
+	op := errs.Op("domain.reviseitem.sqlite.get_user_due_counts")
+	q := sqlc.New(r.db)
+
+	_, offset := tz.In(from).Zone()
+	rows, err := q.GetUserDueCounts(ctx, sqlc.GetUserDueCountsParams{
+		UtcOffset: fmt.Sprintf("%+d seconds", offset),
+		UserID:    userID.String(),
+		FromTime:  from.UTC(),
+		ExcludeID: excludeID.String(),
+	})
+	if err != nil {
+		return scheduler.DueCounts{}, sqliterr.
+			Handle(op, err, "failed to get user due counts").
+			WithContext("user_id", userID)
+	}
+
+	dueCounts := scheduler.NewDueCounts(tz)
+	for _, row := range rows {
+		dueDate, err := time.ParseInLocation(time.DateOnly, row.DueDate, tz.Location())
+		if err != nil {
+			return scheduler.DueCounts{}, errs.
+				NewUnknownError(op, err, "failed to parse due date").
+				WithContext("due_date", row.DueDate)
+		}
+		dueCounts.Add(dueDate, int(row.Count))
+	}
+	return dueCounts, nil
+}
+
+// likeEscaper escapes the wildcards of the LIKE patterns, the queries use the backslash as the escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func stringArrToString(arr []string) sql.NullString {
 	// transform the array into a string: ["a","b","c"] -> "a,b,c"
 	if arr == nil || len(arr) == 0 {
@@ -342,5 +562,65 @@ func modelToReviseItem(model sqlc.ReviseItem) (ReviseItem, error) {
 		lastRevisedAt:  model.LastRevisedAt,
 		nextRevisionAt: model.NextRevisionAt,
 		deletedAt:      deletedAt,
+		memory: scheduler.Memory{
+			Ease:           model.Ease,
+			Stability:      model.Stability,
+			Difficulty:     model.Difficulty,
+			Repetitions:    int(model.Repetitions),
+			Interval:       time.Duration(model.IntervalSeconds) * time.Second,
+			LastReviewedAt: model.LastRevisedAt,
+		},
+		intervalProfileID: uuid.FromStringOrNil(model.IntervalProfileID.String),
+		source:            model.Source.String,
 	}, nil
 }
+
+func modelToQueryReviseItem(model sqlc.ReviseItem) query.ReviseItem {
+	var deletedAt *time.Time
+	if model.DeletedAt.Valid {
+		deletedAt = pointers.New(model.DeletedAt.Time)
+	}
+	return query.ReviseItem{
+		ID:             uuid.FromStringOrNil(model.ID),
+		UserID:         uuid.FromStringOrNil(model.UserID),
+		Name:           model.Name,
+		Description:    model.Description.String,
+		Tags:           valueobject.NewTags(stringToStringArr(model.Tags)...),
+		CreatedAt:      model.CreatedAt,
+		UpdatedAt:      model.UpdatedAt,
+		DeletedAt:      deletedAt,
+		NextRevisionAt: model.NextRevisionAt,
+		LastRevisedAt:  model.LastRevisedAt,
+		Revisions:      nil,
+
+		IntervalProfileID: uuid.FromStringOrNil(model.IntervalProfileID.String),
+		Source:            model.Source.String,
+	}
+}
+
+func modelToRevision(model sqlc.Revision) query.Revision {
+	return query.Revision{
+		ID:         uuid.FromStringOrNil(model.ID),
+		RevisedAt:  model.RevisedAt,
+		Kind:       model.Kind,
+		Grade:      valueobject.Grade(model.Grade),
+		Lapse:      revision.IsLapse(revision.Kind(model.Kind), valueobject.Grade(model.Grade)),
+		Notes:      model.Notes.String,
+		Duration:   time.Duration(model.DurationSeconds) * time.Second,
+		Confidence: valueobject.Confidence(model.Confidence),
+	}
+}
+
+func uuidToNullString(id uuid.UUID) sql.NullString {
+	if id.IsNil() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
+
+func timeToNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
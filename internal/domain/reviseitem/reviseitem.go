@@ -6,6 +6,7 @@ import (
 
 	"github.com/gofrs/uuid"
 
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
 	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
@@ -20,6 +21,8 @@ type ReviseItem struct {
 	name        string
 	description string
 	tags        valueobject.Tags
+	// source is the URL of the source of the item, e.g. the article or the telegram message, empty if none.
+	source string
 
 	createdAt time.Time
 	updatedAt time.Time
@@ -27,6 +30,10 @@ type ReviseItem struct {
 
 	nextRevisionAt time.Time
 	lastRevisedAt  time.Time
+	memory         scheduler.Memory
+
+	// intervalProfileID is the interval profile attached to the item, uuid.Nil if none.
+	intervalProfileID uuid.UUID
 }
 
 // NewReviseItemID creates a new revise item ID.
@@ -40,6 +47,13 @@ type NewReviseItemArgs struct {
 	Name        string
 	Description string
 	Tags        valueobject.Tags
+	// Source is the URL of the source of the item, optional.
+	Source string
+	// IntervalProfileID is the interval profile attached to the item, optional.
+	IntervalProfileID uuid.UUID
+	// ReviewIntervals decides when the item is reviewed for the first time,
+	// the default intervals are used if it is not provided.
+	ReviewIntervals valueobject.ReviewInterval
 }
 
 // NewReviseItem creates a new revise item. It returns an error if the arguments are invalid.
@@ -57,20 +71,28 @@ func NewReviseItem(args NewReviseItemArgs) (*ReviseItem, error) {
 			WithContext("args.UserID", args.UserID)
 	}
 	args.Name = strings.TrimSpace(args.Name)
-	if err := validateName(args.Name); err != nil {
+	if err := ValidateName(args.Name); err != nil {
 		return nil, errs.WithOp(op, err, "validating revise item name failed")
 	}
 	args.Description = strings.TrimSpace(args.Description)
-	if err := validateDescription(args.Description); err != nil {
+	if err := ValidateDescription(args.Description); err != nil {
 		return nil, errs.WithOp(op, err, "validating revise item description failed")
 	}
 	if err := valueobject.ValidateTags(args.Tags); err != nil {
 		return nil, errs.WithOp(op, err, "validating revise item tags failed")
 	}
+	args.Source = strings.TrimSpace(args.Source)
+	if err := ValidateSource(args.Source); err != nil {
+		return nil, errs.WithOp(op, err, "validating revise item source failed")
+	}
 	// if err := validateNextRevisionAt(args.NextRevisionAt); err != nil {
 	// 	return nil, errs.WithOp(op, err, "validating, revise item next revision at failed")
 	// }
 
+	if args.ReviewIntervals.IsZero() {
+		args.ReviewIntervals = valueobject.DefaultReviewIntervals()
+	}
+
 	now := time.Now()
 	return &ReviseItem{
 		id:             args.ID,
@@ -78,9 +100,12 @@ func NewReviseItem(args NewReviseItemArgs) (*ReviseItem, error) {
 		name:           args.Name,
 		description:    args.Description,
 		tags:           args.Tags,
+		source:         args.Source,
 		createdAt:      now,
 		updatedAt:      now,
-		nextRevisionAt: valueobject.DefaultReviewIntervals().Next(0),
+		nextRevisionAt: now.Add(args.ReviewIntervals.At(0)),
+
+		intervalProfileID: args.IntervalProfileID,
 	}, nil
 }
 
@@ -104,6 +129,11 @@ func (r *ReviseItem) Tags() valueobject.Tags {
 	return r.tags
 }
 
+// Source returns the URL of the source of the item, empty if none.
+func (r *ReviseItem) Source() string {
+	return r.source
+}
+
 func (r *ReviseItem) CreatedAt() time.Time {
 	return r.createdAt
 }
@@ -124,9 +154,28 @@ func (r *ReviseItem) LastRevisedAt() time.Time {
 	return r.lastRevisedAt
 }
 
+// Memory returns the memory state used to schedule the next revision.
+func (r *ReviseItem) Memory() scheduler.Memory {
+	memory := r.memory
+	memory.LastReviewedAt = r.lastRevisedAt
+	return memory
+}
+
+// IntervalProfileID returns the interval profile attached to the item, uuid.Nil if none.
+func (r *ReviseItem) IntervalProfileID() uuid.UUID {
+	return r.intervalProfileID
+}
+
+// SetIntervalProfile attaches the interval profile to the item, uuid.Nil detaches it.
+// The new intervals are applied starting from the next review.
+func (r *ReviseItem) SetIntervalProfile(profileID uuid.UUID) {
+	r.intervalProfileID = profileID
+	r.updatedAt = time.Now()
+}
+
 func (r *ReviseItem) UpdateName(name string) error {
 	op := errs.Op("domain.reviseitem.update_name")
-	if err := validateName(name); err != nil {
+	if err := ValidateName(name); err != nil {
 		return errs.WithOp(op, err, "name validation failed")
 	}
 
@@ -138,7 +187,7 @@ func (r *ReviseItem) UpdateName(name string) error {
 
 func (r *ReviseItem) UpdateDescription(description string) error {
 	op := errs.Op("domain.reviseitem.update_description")
-	if err := validateDescription(description); err != nil {
+	if err := ValidateDescription(description); err != nil {
 		return errs.WithOp(op, err, "description validation failed")
 	}
 
@@ -148,6 +197,20 @@ func (r *ReviseItem) UpdateDescription(description string) error {
 	return nil
 }
 
+// UpdateSource changes the URL of the source of the item, an empty source removes it.
+func (r *ReviseItem) UpdateSource(source string) error {
+	op := errs.Op("domain.reviseitem.update_source")
+	source = strings.TrimSpace(source)
+	if err := ValidateSource(source); err != nil {
+		return errs.WithOp(op, err, "source validation failed")
+	}
+
+	r.source = source
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
 func (r *ReviseItem) AddTags(tags valueobject.Tags) error {
 	op := errs.Op("domain.reviseitem.add_tags")
 	if tags.IsEmpty() {
@@ -190,6 +253,19 @@ func (r *ReviseItem) UpdateNextRevisionAt(nextRevisionAt time.Time) error {
 	return nil
 }
 
+// Postpone moves the next revision to the given time without counting as a revision.
+func (r *ReviseItem) Postpone(nextRevisionAt time.Time) error {
+	op := errs.Op("domain.reviseitem.postpone")
+	if err := validateNextRevisionAt(nextRevisionAt); err != nil {
+		return errs.WithOp(op, err, "next revision at validation failed")
+	}
+
+	r.nextRevisionAt = nextRevisionAt
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
 func (r *ReviseItem) MarkAsDeleted() {
 	now := time.Now()
 	r.deletedAt = &now
@@ -42,6 +42,22 @@ func TestNewReviseItem(t *testing.T) {
 				lastRevisedAt:  time.Time{},
 			},
 		},
+		{
+			name: "With custom review intervals",
+			args: customIntervalsNewReviseItemArgs(t, reviseItemID, userID),
+			want: &ReviseItem{
+				id:             reviseItemID,
+				userID:         userID,
+				name:           validName(t, language.Kazakh),
+				description:    validDescription(t, language.Kazakh),
+				tags:           validTags(t),
+				createdAt:      time.Now(),
+				updatedAt:      time.Now(),
+				deletedAt:      nil,
+				nextRevisionAt: time.Now().Add(time.Hour),
+				lastRevisedAt:  time.Time{},
+			},
+		},
 		{
 			name:    "With invalid arguments",
 			args:    invalidNewReviseItemArgs(t, reviseItemID, userID),
@@ -296,6 +312,50 @@ func TestReviseItem_UpdateNextRevisionAt(t *testing.T) {
 	}
 }
 
+func TestReviseItem_Postpone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		nextRevisionAt time.Time
+		wantErr        bool
+	}{
+		{
+			name:           "With future time",
+			nextRevisionAt: time.Now().Add(time.Hour),
+			wantErr:        false,
+		},
+		{
+			name:           "With zero time",
+			nextRevisionAt: time.Time{},
+			wantErr:        true,
+		},
+		{
+			name:           "With past time",
+			nextRevisionAt: time.Now().Add(-time.Hour),
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reviseItem := validReviseItem(t)
+			lastRevisedAt := reviseItem.lastRevisedAt
+
+			err := reviseItem.Postpone(tt.nextRevisionAt)
+			if tt.wantErr {
+				t.Run("Expect error", subtest.Value(err).Error())
+			} else {
+				t.Run("Expect no error", subtest.Value(err).NoError())
+				t.Run("Expect next revision at to be updated", func(t *testing.T) {
+					assert.WithinDuration(t, tt.nextRevisionAt, reviseItem.nextRevisionAt, time.Second)
+				})
+			}
+			t.Run("Expect last revised at to be unchanged", subtest.Value(reviseItem.lastRevisedAt).DeepEqual(lastRevisedAt))
+		})
+	}
+}
+
 func TestReviseItem_MarkAsDeleted(t *testing.T) {
 	t.Parallel()
 
@@ -416,6 +476,17 @@ func validNewReviseItemArgs(t *testing.T, reviseID, userID uuid.UUID) NewReviseI
 	}
 }
 
+func customIntervalsNewReviseItemArgs(t *testing.T, reviseID, userID uuid.UUID) NewReviseItemArgs {
+	t.Helper()
+	intervals, err := valueobject.ParseReviewInterval("1h 1d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := validNewReviseItemArgs(t, reviseID, userID)
+	args.ReviewIntervals = intervals
+	return args
+}
+
 func invalidNewReviseItemArgs(t *testing.T, reviseID, userID uuid.UUID) NewReviseItemArgs {
 	t.Helper()
 	return NewReviseItemArgs{
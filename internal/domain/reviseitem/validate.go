@@ -1,9 +1,12 @@
 package reviseitem
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 
@@ -20,9 +23,11 @@ var (
 const (
 	maxNameLength        = 255
 	maxDescriptionLength = 1024
+	maxSourceLength      = 2048
 )
 
-func validateName(name string) error {
+// ValidateName validates the name of a revise item, so it can be checked before the item is created.
+func ValidateName(name string) error {
 	op := errs.Op("domain.reviseitem.validate_name")
 	name = strings.TrimSpace(name)
 
@@ -41,7 +46,33 @@ func validateName(name string) error {
 	return nil
 }
 
-func validateDescription(description string) error {
+// TruncateName shortens the name to the longest valid name, e.g. when it is taken from a page title or a message.
+func TruncateName(name string) string {
+	return truncate(name, maxNameLength)
+}
+
+// TruncateDescription shortens the description to the longest valid description.
+func TruncateDescription(description string) string {
+	return truncate(description, maxDescriptionLength)
+}
+
+// truncate shortens the string to maxLength bytes, the lengths are validated in bytes,
+// cutting it on a rune boundary and marking the cut with an ellipsis.
+func truncate(s string, maxLength int) string {
+	const ellipsis = "…"
+	s = strings.TrimSpace(s)
+	if len(s) <= maxLength {
+		return s
+	}
+	cut := maxLength - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + ellipsis
+}
+
+// ValidateDescription validates the description of a revise item, an empty description is valid.
+func ValidateDescription(description string) error {
 	op := errs.Op("domain.reviseitem.validate_description")
 	description = strings.TrimSpace(description)
 
@@ -59,6 +90,34 @@ func validateDescription(description string) error {
 	return nil
 }
 
+// ValidateSource validates the source of a revise item, it must be an absolute http(s) URL.
+// An empty source is valid.
+func ValidateSource(source string) error {
+	op := errs.Op("domain.reviseitem.validate_source")
+	if source == "" {
+		return nil
+	}
+
+	err := validation.Validate(
+		source,
+		validation.Length(1, maxSourceLength).
+			Error(fmt.Sprintf("source must be at most %d characters", maxSourceLength)),
+	)
+	if err == nil {
+		u, parseErr := url.Parse(source)
+		if parseErr != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			err = errors.New("source must be an http or https URL")
+		}
+	}
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid source").
+			WithMessages([]errs.Message{{Key: "message", Value: err.Error()}}).
+			WithContext("source", source)
+	}
+	return nil
+}
+
 func validateNextRevisionAt(nextRevisionAt time.Time) error {
 	op := errs.Op("domain.reviseitem.validate_next_revision_at")
 	if nextRevisionAt.IsZero() {
@@ -38,7 +38,7 @@ func TestValidateName(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateName(tt.username)
+			err := ValidateName(tt.username)
 			t.Run("Expected no error", subtest.Value(err).NoError())
 		})
 	}
@@ -84,7 +84,7 @@ func TestValidateName_Invalid(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			expectedErrorType := errs.ErrorTypeIncorrectInput
-			err := validateName(tt.username)
+			err := ValidateName(tt.username)
 			t.Run("Expected", func(t *testing.T) {
 				require.Error(t, err, "error is expected")
 				assert.IsType(t, &errs.Error{}, err, "expected error type")
@@ -121,7 +121,7 @@ func TestValidateDescription(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validateDescription(tt.description)
+			err := ValidateDescription(tt.description)
 			t.Run("Expect no error", subtest.Value(err).NoError())
 		})
 	}
@@ -143,7 +143,7 @@ func TestValidateDescription_Invalid(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			expectedErrorType := errs.ErrorTypeIncorrectInput
-			err := validateDescription(tt.description)
+			err := ValidateDescription(tt.description)
 			t.Run("Expected", func(t *testing.T) {
 				require.Error(t, err, "error is expected")
 				assert.IsType(t, &errs.Error{}, err, "expected error type")
@@ -153,6 +153,76 @@ func TestValidateDescription_Invalid(t *testing.T) {
 	}
 }
 
+func TestValidateSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{
+			name:   "With https source",
+			source: "https://go.dev/ref/mem",
+		},
+		{
+			name:   "With telegram message source",
+			source: "https://t.me/golang_news/1024",
+		},
+		{
+			name:   "With empty source",
+			source: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSource(tt.source)
+			t.Run("Expect no error", subtest.Value(err).NoError())
+		})
+	}
+}
+
+func TestValidateSource_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{
+			name:   "With relative source",
+			source: "/ref/mem",
+		},
+		{
+			name:   "With not http source",
+			source: "ftp://example.com/file",
+		},
+		{
+			name:   "With long source",
+			source: "https://example.com/" + strings.Repeat("a", maxSourceLength),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSource(tt.source)
+			require.Error(t, err, "error is expected")
+			assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput), "expected error type")
+		})
+	}
+}
+
+func TestTruncateName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Go maps", TruncateName("  Go maps "))
+
+	truncated := TruncateName(strings.Repeat("я", maxNameLength+10))
+	assert.LessOrEqual(t, len(truncated), maxNameLength)
+	assert.True(t, strings.HasSuffix(truncated, "…"))
+	assert.NoError(t, ValidateName(truncated))
+}
+
 func TestValidateNextRevisionAt(t *testing.T) {
 	t.Parallel()
 
@@ -2,9 +2,15 @@ package revision
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
 var (
@@ -12,14 +18,45 @@ var (
 	ErrInvalidReviseItemID = errors.New("invalid revise item id")
 )
 
+const (
+	maxNotesLength = 4096
+	maxDuration    = 24 * time.Hour
+)
+
+// Kind tells how a revision affected the progress of the revise item.
+type Kind string
+
+const (
+	// KindReview is a regular review graded by the user.
+	KindReview Kind = "review"
+	// KindRelapse is a review of a forgotten item, which is moved back on the interval ladder.
+	KindRelapse Kind = "relapse"
+	// KindReset marks that the progress of the item was restarted, it has no grade.
+	KindReset Kind = "reset"
+)
+
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindReview, KindRelapse, KindReset:
+		return true
+	default:
+		return false
+	}
+}
+
 // Revision represents a revision of a revise item.
 // A revision is a snapshot of a revise item at a certain time.
-// It is used to track the history of a revise item.
+// It is used to track the history of a revise item, together with the optional
+// notes, time spent and confidence it forms a learning journal of the item.
 // It is immutable.
 type Revision struct {
-	id        uuid.UUID
-	revisedAt time.Time
-	// Notes        string // maybe in the future
+	id         uuid.UUID
+	revisedAt  time.Time
+	kind       Kind
+	grade      valueobject.Grade
+	notes      string
+	duration   time.Duration
+	confidence valueobject.Confidence
 }
 
 func (r *Revision) ID() uuid.UUID {
@@ -30,13 +67,109 @@ func (r *Revision) RevisedAt() time.Time {
 	return r.revisedAt
 }
 
+func (r *Revision) Kind() Kind {
+	return r.kind
+}
+
+// Grade returns the grade of the revision, zero for resets.
+func (r *Revision) Grade() valueobject.Grade {
+	return r.grade
+}
+
+// IsLapse reports whether the item was forgotten.
+func (r *Revision) IsLapse() bool {
+	return IsLapse(r.kind, r.grade)
+}
+
+// IsLapse reports whether a revision of the given kind and grade marks a forgotten item:
+// the progress was reset, the item relapsed or the review was graded again.
+func IsLapse(kind Kind, grade valueobject.Grade) bool {
+	return kind == KindRelapse || kind == KindReset || grade == valueobject.GradeAgain
+}
+
+// Notes returns the free-text notes, empty if none.
+func (r *Revision) Notes() string {
+	return r.notes
+}
+
+// Duration returns the time spent on the revision, zero if not tracked.
+func (r *Revision) Duration() time.Duration {
+	return r.duration
+}
+
+// Confidence returns the self-rated confidence, valueobject.ConfidenceNotRated if not rated.
+func (r *Revision) Confidence() valueobject.Confidence {
+	return r.confidence
+}
+
 func NewRevisionID() uuid.UUID {
 	return uuid.Must(uuid.NewV7())
 }
 
-func NewRevision() *Revision {
+type NewRevisionArgs struct {
+	// Kind defaults to KindReview.
+	Kind  Kind
+	Grade valueobject.Grade
+	// Notes, Duration and Confidence are optional.
+	Notes      string
+	Duration   time.Duration
+	Confidence valueobject.Confidence
+}
+
+func NewRevision(args NewRevisionArgs) (*Revision, error) {
+	op := errs.Op("domain.revision.new_revision")
+	notes := strings.TrimSpace(args.Notes)
+	if args.Kind == "" {
+		args.Kind = KindReview
+	}
+
+	if err := validateRevision(args.Kind, args.Grade, notes, args.Duration, args.Confidence); err != nil {
+		return nil, errs.WithOp(op, err, "invalid revision")
+	}
+
 	return &Revision{
-		id:        NewRevisionID(),
-		revisedAt: time.Now(),
+		id:         NewRevisionID(),
+		revisedAt:  time.Now(),
+		kind:       args.Kind,
+		grade:      args.Grade,
+		notes:      notes,
+		duration:   args.Duration,
+		confidence: args.Confidence,
+	}, nil
+}
+
+func validateRevision(
+	kind Kind,
+	grade valueobject.Grade,
+	notes string,
+	duration time.Duration,
+	confidence valueobject.Confidence,
+) error {
+	op := errs.Op("domain.revision.validate_revision")
+
+	var message string
+	switch {
+	case !kind.IsValid():
+		message = "kind must be one of: review, relapse, reset"
+	case kind == KindReset && grade != 0:
+		message = "reset must not be graded"
+	case kind != KindReset && !grade.IsValid():
+		message = "grade must be one of: again, hard, good, easy"
+	case validation.Validate(notes, validation.RuneLength(0, maxNotesLength)) != nil:
+		message = fmt.Sprintf("notes must be at most %d characters", maxNotesLength)
+	case duration < 0 || duration > maxDuration:
+		message = "duration must be between 0 and 24 hours"
+	case !confidence.IsValid():
+		message = "confidence must be between 1 and 5"
+	default:
+		return nil
 	}
+
+	return errs.
+		NewIncorrectInputError(op, errs.ErrInvalidInput, message).
+		WithMessages([]errs.Message{{Key: "message", Value: message}}).
+		WithContext("kind", kind).
+		WithContext("grade", grade).
+		WithContext("duration", duration).
+		WithContext("confidence", confidence)
 }
@@ -0,0 +1,127 @@
+package scheduler
+
+import (
+	"math"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+const (
+	fsrsDecay  = -0.5
+	fsrsFactor = 19.0 / 81.0
+
+	fsrsDefaultRetention = 0.9
+	fsrsMaxIntervalDays  = 36500
+)
+
+// fsrsDefaultWeights are the default FSRS-4.5 model weights.
+var fsrsDefaultWeights = [17]float64{
+	0.4872, 1.4003, 3.7145, 13.8206,
+	5.1618, 1.2298, 0.8975, 0.031,
+	1.6474, 0.1367, 1.0461, 2.1072,
+	0.0793, 0.3246, 1.587, 0.2272,
+	2.8755,
+}
+
+// FSRS implements the Free Spaced Repetition Scheduler (FSRS-4.5).
+// The intervals are kept within the first and the last review interval of the user,
+// the zero review intervals leave them as the model decides.
+//
+//	See: https://github.com/open-spaced-repetition/fsrs4anki/wiki/The-Algorithm
+type FSRS struct {
+	weights [17]float64
+	// retention is the probability of recall the intervals are calculated for.
+	retention float64
+	intervals valueobject.ReviewInterval
+}
+
+func NewFSRS(intervals valueobject.ReviewInterval) FSRS {
+	return FSRS{
+		weights:   fsrsDefaultWeights,
+		retention: fsrsDefaultRetention,
+		intervals: intervals,
+	}
+}
+
+func (f FSRS) Schedule(memory Memory, grade valueobject.Grade, now time.Time) Memory {
+	g := float64(grade)
+
+	if memory.IsNew() || memory.Stability == 0 {
+		memory.Stability = f.initStability(g)
+		memory.Difficulty = f.initDifficulty(g)
+	} else {
+		elapsed := math.Max(now.Sub(memory.LastReviewedAt).Hours()/24, 0)
+		r := retrievability(elapsed, memory.Stability)
+		if grade == valueobject.GradeAgain {
+			memory.Stability = f.forgetStability(memory.Difficulty, memory.Stability, r)
+		} else {
+			memory.Stability = f.recallStability(memory.Difficulty, memory.Stability, r, grade)
+		}
+		memory.Difficulty = f.nextDifficulty(memory.Difficulty, g)
+	}
+
+	if grade == valueobject.GradeAgain {
+		memory.Repetitions = 0
+	} else {
+		memory.Repetitions++
+	}
+	memory.Interval = clampInterval(days(f.intervalDays(memory.Stability)), f.intervals)
+	memory.LastReviewedAt = now
+
+	return memory
+}
+
+func (f FSRS) initStability(g float64) float64 {
+	return math.Max(f.weights[int(g)-1], 0.1)
+}
+
+// initDifficulty is the FSRS-4.5 D0(G) = w4 - (G-3)*w5, a good first review starts at w4.
+func (f FSRS) initDifficulty(g float64) float64 {
+	return clampDifficulty(f.weights[4] - (g-3)*f.weights[5])
+}
+
+func (f FSRS) nextDifficulty(d, g float64) float64 {
+	next := d - f.weights[6]*(g-3)
+	// mean reversion towards the difficulty of a good first review
+	return clampDifficulty(f.weights[7]*f.initDifficulty(3) + (1-f.weights[7])*next)
+}
+
+func (f FSRS) recallStability(d, s, r float64, grade valueobject.Grade) float64 {
+	hardPenalty, easyBonus := 1.0, 1.0
+	switch grade {
+	case valueobject.GradeHard:
+		hardPenalty = f.weights[15]
+	case valueobject.GradeEasy:
+		easyBonus = f.weights[16]
+	}
+
+	return s * (1 + math.Exp(f.weights[8])*
+		(11-d)*
+		math.Pow(s, -f.weights[9])*
+		(math.Exp((1-r)*f.weights[10])-1)*
+		hardPenalty*
+		easyBonus)
+}
+
+func (f FSRS) forgetStability(d, s, r float64) float64 {
+	next := f.weights[11] *
+		math.Pow(d, -f.weights[12]) *
+		(math.Pow(s+1, f.weights[13]) - 1) *
+		math.Exp((1-r)*f.weights[14])
+	return math.Min(next, s)
+}
+
+func (f FSRS) intervalDays(stability float64) float64 {
+	interval := stability / fsrsFactor * (math.Pow(f.retention, 1/fsrsDecay) - 1)
+	return math.Min(math.Max(math.Round(interval), 1), fsrsMaxIntervalDays)
+}
+
+// retrievability returns the probability of recall after elapsed days with the given stability.
+func retrievability(elapsed, stability float64) float64 {
+	return math.Pow(1+fsrsFactor*elapsed/stability, fsrsDecay)
+}
+
+func clampDifficulty(d float64) float64 {
+	return math.Min(math.Max(d, 1), 10)
+}
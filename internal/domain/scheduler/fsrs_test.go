@@ -0,0 +1,134 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestFSRS_Schedule_NewItem(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name           string
+		grade          valueobject.Grade
+		wantStability  float64
+		wantDifficulty float64
+		wantInterval   time.Duration
+	}{
+		{
+			name:           "With again grade",
+			grade:          valueobject.GradeAgain,
+			wantStability:  0.4872,
+			wantDifficulty: 7.6214,
+			wantInterval:   days(1),
+		},
+		{
+			name:           "With hard grade",
+			grade:          valueobject.GradeHard,
+			wantStability:  1.4003,
+			wantDifficulty: 6.3916,
+			wantInterval:   days(1),
+		},
+		{
+			name:           "With good grade",
+			grade:          valueobject.GradeGood,
+			wantStability:  3.7145,
+			wantDifficulty: 5.1618,
+			wantInterval:   days(4),
+		},
+		{
+			name:           "With easy grade",
+			grade:          valueobject.GradeEasy,
+			wantStability:  13.8206,
+			wantDifficulty: 3.932,
+			wantInterval:   days(14),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewFSRS(valueobject.ReviewInterval{}).Schedule(Memory{}, tt.grade, now)
+
+			t.Run("Expect initial memory state", func(t *testing.T) {
+				assert.InDelta(t, tt.wantStability, got.Stability, 0.0001)
+				assert.InDelta(t, tt.wantDifficulty, got.Difficulty, 0.0001)
+				assert.Equal(t, tt.wantInterval, got.Interval)
+				assert.Equal(t, now, got.LastReviewedAt)
+			})
+		})
+	}
+}
+
+func TestFSRS_Schedule_ReviewedItem(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	memory := Memory{
+		Stability:      10,
+		Difficulty:     5,
+		Repetitions:    3,
+		Interval:       days(10),
+		LastReviewedAt: now.Add(-10 * day),
+	}
+
+	again := NewFSRS(valueobject.ReviewInterval{}).Schedule(memory, valueobject.GradeAgain, now)
+	hard := NewFSRS(valueobject.ReviewInterval{}).Schedule(memory, valueobject.GradeHard, now)
+	good := NewFSRS(valueobject.ReviewInterval{}).Schedule(memory, valueobject.GradeGood, now)
+	easy := NewFSRS(valueobject.ReviewInterval{}).Schedule(memory, valueobject.GradeEasy, now)
+
+	t.Run("Expect again grade to decrease stability", func(t *testing.T) {
+		assert.Less(t, again.Stability, memory.Stability)
+		assert.Equal(t, 0, again.Repetitions)
+	})
+	t.Run("Expect stability to grow with the grade", func(t *testing.T) {
+		assert.Greater(t, hard.Stability, memory.Stability)
+		assert.Greater(t, good.Stability, hard.Stability)
+		assert.Greater(t, easy.Stability, good.Stability)
+		assert.Equal(t, 4, good.Repetitions)
+	})
+	t.Run("Expect difficulty to decrease with the grade", func(t *testing.T) {
+		assert.Greater(t, again.Difficulty, hard.Difficulty)
+		assert.Greater(t, hard.Difficulty, good.Difficulty)
+		assert.Greater(t, good.Difficulty, easy.Difficulty)
+	})
+	t.Run("Expect good grade to revert difficulty towards the initial one", func(t *testing.T) {
+		// w7*D0(3) + (1-w7)*D = 0.031*5.1618 + 0.969*5
+		assert.InDelta(t, 5.0050, good.Difficulty, 0.0001)
+	})
+	t.Run("Expect interval to grow with the grade", func(t *testing.T) {
+		assert.LessOrEqual(t, again.Interval, hard.Interval)
+		assert.Less(t, hard.Interval, good.Interval)
+		assert.Less(t, good.Interval, easy.Interval)
+	})
+}
+
+func TestFSRS_Schedule_ReviewIntervals(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	intervals, err := valueobject.ParseReviewInterval("3d 1w 2w 3w 1M 45d 2M 3M 4M 6M 9M 1y 18M 2y 3y 5y 6M")
+	require.NoError(t, err)
+	fsrs := NewFSRS(intervals)
+
+	t.Run("Expect interval not shorter than the first review interval", func(t *testing.T) {
+		got := fsrs.Schedule(Memory{}, valueobject.GradeAgain, now)
+		assert.Equal(t, days(3), got.Interval)
+	})
+	t.Run("Expect interval not longer than the last review interval", func(t *testing.T) {
+		memory := Memory{Stability: 1000, Difficulty: 3, Repetitions: 10, LastReviewedAt: now.Add(-1000 * day)}
+		got := fsrs.Schedule(memory, valueobject.GradeEasy, now)
+		assert.Equal(t, intervals.At(intervals.Len()-1), got.Interval)
+	})
+	t.Run("Expect interval within the review intervals to be kept", func(t *testing.T) {
+		got := fsrs.Schedule(Memory{}, valueobject.GradeEasy, now)
+		want := NewFSRS(valueobject.ReviewInterval{}).Schedule(Memory{}, valueobject.GradeEasy, now)
+		assert.Equal(t, want.Interval, got.Interval)
+	})
+}
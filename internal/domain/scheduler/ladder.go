@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+// Ladder moves the item along a fixed ladder of review intervals.
+// Memory.Repetitions is the position of the item on the ladder.
+//
+//	again - back to the first interval
+//	hard  - repeat the current interval
+//	good  - one interval up
+//	easy  - two intervals up
+type Ladder struct {
+	intervals valueobject.ReviewInterval
+}
+
+func NewLadder(intervals valueobject.ReviewInterval) Ladder {
+	if intervals.IsZero() {
+		intervals = valueobject.DefaultReviewIntervals()
+	}
+	return Ladder{intervals: intervals}
+}
+
+func (l Ladder) Schedule(memory Memory, grade valueobject.Grade, now time.Time) Memory {
+	switch grade {
+	case valueobject.GradeAgain:
+		memory.Repetitions = 0
+	case valueobject.GradeGood:
+		memory.Repetitions++
+	case valueobject.GradeEasy:
+		memory.Repetitions += 2
+	}
+	if last := l.intervals.Len() - 1; memory.Repetitions > last {
+		memory.Repetitions = last
+	}
+
+	memory.Interval = l.intervals.At(memory.Repetitions)
+	memory.LastReviewedAt = now
+
+	return memory
+}
+
+// Relapse moves the item the given number of intervals down the ladder.
+func (l Ladder) Relapse(memory Memory, steps int, now time.Time) Memory {
+	memory.Repetitions = max(memory.Repetitions-steps, 0)
+	memory.Interval = l.intervals.At(memory.Repetitions)
+	memory.LastReviewedAt = now
+
+	return memory
+}
@@ -0,0 +1,130 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestLadder_Schedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	intervals, err := valueobject.ParseReviewInterval("1h 1d 3d 1w")
+	require.NoError(t, err)
+	last := intervals.Len() - 1
+
+	tests := []struct {
+		name   string
+		memory Memory
+		grade  valueobject.Grade
+		want   Memory
+	}{
+		{
+			name:   "With new item and good grade",
+			memory: Memory{},
+			grade:  valueobject.GradeGood,
+			want:   Memory{Repetitions: 1, Interval: day, LastReviewedAt: now},
+		},
+		{
+			name:   "With easy grade",
+			memory: Memory{Repetitions: 1, Interval: day},
+			grade:  valueobject.GradeEasy,
+			want:   Memory{Repetitions: 3, Interval: 7 * day, LastReviewedAt: now},
+		},
+		{
+			name:   "With hard grade",
+			memory: Memory{Repetitions: 2, Interval: 3 * day},
+			grade:  valueobject.GradeHard,
+			want:   Memory{Repetitions: 2, Interval: 3 * day, LastReviewedAt: now},
+		},
+		{
+			name:   "With again grade",
+			memory: Memory{Repetitions: 3, Interval: 7 * day},
+			grade:  valueobject.GradeAgain,
+			want:   Memory{Repetitions: 0, Interval: time.Hour, LastReviewedAt: now},
+		},
+		{
+			name:   "With the last interval",
+			memory: Memory{Repetitions: last, Interval: intervals.At(last)},
+			grade:  valueobject.GradeEasy,
+			want:   Memory{Repetitions: last, Interval: intervals.At(last), LastReviewedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewLadder(intervals).Schedule(tt.memory, tt.grade, now)
+
+			t.Run("Expect memory to be updated", func(t *testing.T) {
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
+
+func TestRelapse(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	intervals, err := valueobject.ParseReviewInterval("1h 1d 3d 1w")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		scheduler Scheduler
+		memory    Memory
+		steps     int
+		want      Memory
+	}{
+		{
+			name:      "With ladder one step back",
+			scheduler: NewLadder(intervals),
+			memory:    Memory{Repetitions: 3, Interval: 7 * day},
+			steps:     1,
+			want:      Memory{Repetitions: 2, Interval: 3 * day, LastReviewedAt: now},
+		},
+		{
+			name:      "With ladder more steps than taken",
+			scheduler: NewLadder(intervals),
+			memory:    Memory{Repetitions: 1, Interval: day},
+			steps:     3,
+			want:      Memory{Repetitions: 0, Interval: time.Hour, LastReviewedAt: now},
+		},
+		{
+			name:      "With SM-2 one step back",
+			scheduler: NewSM2(valueobject.ReviewInterval{}),
+			memory:    Memory{Ease: 2.5, Repetitions: 4, Interval: 38 * day},
+			steps:     1,
+			want:      Memory{Ease: 2.5, Repetitions: 3, Interval: 15 * day, LastReviewedAt: now},
+		},
+		{
+			name:      "With SM-2 back to the second repetition",
+			scheduler: NewSM2(valueobject.ReviewInterval{}),
+			memory:    Memory{Ease: 2.5, Repetitions: 4, Interval: 38 * day},
+			steps:     2,
+			want:      Memory{Ease: 2.5, Repetitions: 2, Interval: 6 * day, LastReviewedAt: now},
+		},
+		{
+			name:      "With scheduler without steps",
+			scheduler: NewFSRS(valueobject.ReviewInterval{}),
+			memory:    Memory{},
+			steps:     1,
+			want:      NewFSRS(valueobject.ReviewInterval{}).Schedule(Memory{}, valueobject.GradeAgain, now),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Relapse(tt.scheduler, tt.memory, tt.steps, now)
+
+			t.Run("Expect memory to be moved back", func(t *testing.T) {
+				assert.Equal(t, tt.want, got)
+			})
+		})
+	}
+}
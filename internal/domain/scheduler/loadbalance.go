@@ -0,0 +1,114 @@
+package scheduler
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+const (
+	// loadBalanceMinInterval is the shortest interval spread across neighbouring days.
+	loadBalanceMinInterval = 3 * day
+	// loadBalanceFuzz is the part of the interval the due date may be moved by.
+	loadBalanceFuzz = 0.05
+	// loadBalanceMaxDays is the most days the due date may be moved by.
+	loadBalanceMaxDays = 7
+)
+
+// DueCounts is the number of items due per local day of the user.
+type DueCounts struct {
+	tz valueobject.Timezone
+	// days are keyed by the local date at midnight UTC.
+	days map[time.Time]int
+}
+
+// NewDueCounts returns empty due counts of the days in the time zone.
+func NewDueCounts(tz valueobject.Timezone) DueCounts {
+	return DueCounts{tz: tz, days: make(map[time.Time]int)}
+}
+
+// Add counts the items due on the local day of the given time.
+func (d DueCounts) Add(dueAt time.Time, count int) {
+	d.days[d.day(dueAt)] += count
+}
+
+// On returns the number of items due on the local day of the given time.
+func (d DueCounts) On(t time.Time) int {
+	return d.days[d.day(t)]
+}
+
+// Days returns the number of days with due items.
+func (d DueCounts) Days() int {
+	return len(d.days)
+}
+
+func (d DueCounts) day(t time.Time) time.Time {
+	year, month, date := d.tz.In(t).Date()
+	return time.Date(year, month, date, 0, 0, 0, 0, time.UTC)
+}
+
+// LoadBalancer spreads the due dates decided by the wrapped scheduler across
+// neighbouring days, so items reviewed together do not come due together.
+// The due date is moved to the least loaded day within the fuzz range,
+// ties are broken by the item id so the result is deterministic per item.
+type LoadBalancer struct {
+	Scheduler
+	itemID    uuid.UUID
+	dueCounts DueCounts
+}
+
+// NewLoadBalancer returns a scheduler balancing the due dates of the given item
+// by the due counts of the other items of the user.
+func NewLoadBalancer(s Scheduler, itemID uuid.UUID, dueCounts DueCounts) LoadBalancer {
+	return LoadBalancer{Scheduler: s, itemID: itemID, dueCounts: dueCounts}
+}
+
+func (l LoadBalancer) Schedule(memory Memory, grade valueobject.Grade, now time.Time) Memory {
+	return l.balance(l.Scheduler.Schedule(memory, grade, now), now)
+}
+
+func (l LoadBalancer) Relapse(memory Memory, steps int, now time.Time) Memory {
+	return l.balance(Relapse(l.Scheduler, memory, steps, now), now)
+}
+
+func (l LoadBalancer) balance(memory Memory, now time.Time) Memory {
+	fuzz := fuzzDays(memory.Interval)
+	if fuzz == 0 {
+		return memory
+	}
+
+	dueAt := now.Add(memory.Interval)
+	best, bestCount, bestRank := 0, math.MaxInt, uint64(math.MaxUint64)
+	for offset := -fuzz; offset <= fuzz; offset++ {
+		candidate := dueAt.Add(time.Duration(offset) * day)
+		count, rank := l.dueCounts.On(candidate), l.rank(candidate)
+		if count < bestCount || (count == bestCount && rank < bestRank) {
+			best, bestCount, bestRank = offset, count, rank
+		}
+	}
+
+	memory.Interval += time.Duration(best) * day
+	return memory
+}
+
+// rank orders the days for the item, it is stable for the same item and day.
+func (l LoadBalancer) rank(t time.Time) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(l.itemID.Bytes())
+	_ = binary.Write(h, binary.LittleEndian, l.dueCounts.day(t).Unix())
+	return h.Sum64()
+}
+
+// fuzzDays returns how many days the due date after the given interval may be moved by.
+func fuzzDays(interval time.Duration) int {
+	if interval < loadBalanceMinInterval {
+		return 0
+	}
+	fuzz := int(math.Round(interval.Hours() / 24 * loadBalanceFuzz))
+	return min(max(fuzz, 1), loadBalanceMaxDays)
+}
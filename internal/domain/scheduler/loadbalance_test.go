@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestLoadBalancer_Schedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	itemID := uuid.FromStringOrNil("d7accc08-981f-4aa7-8477-b1840b9a2611")
+	intervals, err := valueobject.ParseReviewInterval("1d 2d 1w 1M")
+	require.NoError(t, err)
+	ladder := NewLadder(intervals)
+
+	tests := []struct {
+		name         string
+		memory       Memory
+		dueCounts    DueCounts
+		wantInterval time.Duration
+		// wantRange is the allowed deviation from the interval when the exact day is not asserted.
+		wantRange time.Duration
+	}{
+		{
+			name:         "With interval too short to balance",
+			memory:       Memory{},
+			dueCounts:    dueCountsOf(now.Add(2*day), 10),
+			wantInterval: 2 * day,
+		},
+		{
+			name:         "With loaded due day and one free neighbour",
+			memory:       Memory{Repetitions: 1, Interval: 2 * day},
+			dueCounts:    merge(dueCountsOf(now.Add(7*day), 5), dueCountsOf(now.Add(6*day), 3), dueCountsOf(now.Add(8*day), 1)),
+			wantInterval: 8 * day,
+		},
+		{
+			name:         "With free due day",
+			memory:       Memory{Repetitions: 1, Interval: 2 * day},
+			dueCounts:    merge(dueCountsOf(now.Add(6*day), 3), dueCountsOf(now.Add(8*day), 1)),
+			wantInterval: 7 * day,
+		},
+		{
+			name:      "With long interval and no due items",
+			memory:    Memory{Repetitions: 2, Interval: 7 * day},
+			dueCounts: DueCounts{},
+			// 5% of a month rounds to 2 days
+			wantInterval: 30 * day,
+			wantRange:    2 * day,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			balancer := NewLoadBalancer(ladder, itemID, tt.dueCounts)
+			got := balancer.Schedule(tt.memory, valueobject.GradeGood, now)
+
+			t.Run("Expect interval within range", func(t *testing.T) {
+				assert.InDelta(t, tt.wantInterval, got.Interval, float64(tt.wantRange))
+				assert.Zero(t, got.Interval%day, "balanced interval must move by whole days")
+			})
+			t.Run("Expect deterministic result", func(t *testing.T) {
+				again := NewLoadBalancer(ladder, itemID, tt.dueCounts).Schedule(tt.memory, valueobject.GradeGood, now)
+				assert.Equal(t, got, again)
+			})
+		})
+	}
+}
+
+func TestLoadBalancer_Spread(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	ladder := NewLadder(valueobject.DefaultReviewIntervals())
+	memory := Memory{Repetitions: 4, Interval: ladder.intervals.At(4)}
+
+	dueCounts := NewDueCounts(valueobject.UTC())
+	for range 30 {
+		got := NewLoadBalancer(ladder, uuid.Must(uuid.NewV7()), dueCounts).Schedule(memory, valueobject.GradeGood, now)
+		dueCounts.Add(now.Add(got.Interval), 1)
+	}
+
+	// a month interval may move by two days both ways
+	assert.Equal(t, 5, dueCounts.Days(), "items must be spread across neighbouring days")
+	for dueDay, count := range dueCounts.days {
+		assert.Equal(t, 6, count, "day %s must have an even share", dueDay)
+	}
+}
+
+func dueCountsOf(t time.Time, count int) DueCounts {
+	dueCounts := NewDueCounts(valueobject.UTC())
+	dueCounts.Add(t, count)
+	return dueCounts
+}
+
+func merge(counts ...DueCounts) DueCounts {
+	merged := NewDueCounts(valueobject.UTC())
+	for _, c := range counts {
+		for k, v := range c.days {
+			merged.days[k] += v
+		}
+	}
+	return merged
+}
+
+func TestDueCounts(t *testing.T) {
+	t.Parallel()
+
+	tz, err := valueobject.ParseTimezone("Asia/Almaty")
+	require.NoError(t, err)
+	dueCounts := NewDueCounts(tz)
+
+	// 20:00 UTC is 01:00 of the next day in Almaty
+	dueCounts.Add(time.Date(2026, 10, 17, 20, 0, 0, 0, time.UTC), 2)
+	dueCounts.Add(time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC), 1)
+
+	assert.Equal(t, 1, dueCounts.Days())
+	assert.Equal(t, 3, dueCounts.On(time.Date(2026, 10, 18, 0, 30, 0, 0, tz.Location())))
+	assert.Zero(t, dueCounts.On(time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Memory represents the memory state of a revise item.
+// Each algorithm uses only the fields it needs, the zero value means the item was never reviewed.
+type Memory struct {
+	// Ease is the SM-2 easiness factor.
+	Ease float64
+	// Stability is the FSRS memory stability in days.
+	Stability float64
+	// Difficulty is the FSRS item difficulty, between 1 and 10.
+	Difficulty float64
+	// Repetitions is the number of consecutive successful reviews.
+	Repetitions int
+	// Interval is the interval between the last review and the next one.
+	Interval time.Duration
+	// LastReviewedAt is the time of the last review, zero if the item was never reviewed.
+	LastReviewedAt time.Time
+}
+
+// IsNew reports whether the item was never reviewed.
+func (m Memory) IsNew() bool {
+	return m.LastReviewedAt.IsZero()
+}
+
+// Scheduler decides when a revise item should be reviewed next.
+type Scheduler interface {
+	// Schedule returns the memory state after a review graded with the given grade at the given time.
+	// The returned Memory.Interval is the time until the next review.
+	Schedule(memory Memory, grade valueobject.Grade, now time.Time) Memory
+}
+
+// Relapser is implemented by schedulers that can move a forgotten item back
+// a number of steps instead of restarting it.
+type Relapser interface {
+	// Relapse returns the memory state after the item was moved back the given number of steps at the given time.
+	Relapse(memory Memory, steps int, now time.Time) Memory
+}
+
+// Relapse moves the item back the given number of steps, schedulers without
+// steps treat the relapse as a review graded again.
+func Relapse(s Scheduler, memory Memory, steps int, now time.Time) Memory {
+	if r, ok := s.(Relapser); ok {
+		return r.Relapse(memory, steps, now)
+	}
+	return s.Schedule(memory, valueobject.GradeAgain, now)
+}
+
+// Algorithm is the name of a scheduling algorithm.
+type Algorithm string
+
+const (
+	AlgorithmLadder Algorithm = "ladder"
+	AlgorithmSM2    Algorithm = "sm2"
+	AlgorithmFSRS   Algorithm = "fsrs"
+)
+
+// ParseAlgorithm returns the algorithm with the given name.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	op := errs.Op("domain.scheduler.parse_algorithm")
+	switch algorithm := Algorithm(name); algorithm {
+	case AlgorithmLadder, AlgorithmSM2, AlgorithmFSRS:
+		return algorithm, nil
+	default:
+		return "", errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "unknown scheduling algorithm").
+			WithMessages([]errs.Message{{Key: "message", Value: "unknown scheduling algorithm"}}).
+			WithContext("algorithm", name)
+	}
+}
+
+// New returns a scheduler for the given algorithm and the review intervals of the user.
+// The ladder algorithm follows the review intervals, SM-2 starts with the first two of them
+// and both SM-2 and FSRS keep their intervals within the first and the last one.
+func New(algorithm Algorithm, intervals valueobject.ReviewInterval) (Scheduler, error) {
+	op := errs.Op("domain.scheduler.new")
+	switch algorithm {
+	case AlgorithmLadder:
+		return NewLadder(intervals), nil
+	case AlgorithmSM2:
+		return NewSM2(intervals), nil
+	case AlgorithmFSRS:
+		return NewFSRS(intervals), nil
+	default:
+		return nil, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "unknown scheduling algorithm").
+			WithMessages([]errs.Message{{Key: "message", Value: "unknown scheduling algorithm"}}).
+			WithContext("algorithm", algorithm)
+	}
+}
+
+const day = 24 * time.Hour
+
+// clampInterval keeps the interval within the first and the last review interval,
+// the zero review intervals leave it as it is.
+func clampInterval(interval time.Duration, intervals valueobject.ReviewInterval) time.Duration {
+	if intervals.IsZero() {
+		return interval
+	}
+	return min(max(interval, intervals.At(0)), intervals.At(intervals.Len()-1))
+}
+
+// days converts days to a duration.
+func days(d float64) time.Duration {
+	return time.Duration(d * float64(day))
+}
@@ -0,0 +1,113 @@
+package scheduler
+
+import (
+	"math"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+const (
+	sm2DefaultEase = 2.5
+	sm2MinEase     = 1.3
+)
+
+// sm2Steps are the first two intervals of the classic SM-2.
+var sm2Steps = [2]time.Duration{1 * day, 6 * day}
+
+// SM2 implements the SuperMemo-2 algorithm.
+// The first two review intervals of the user replace the first two SM-2 intervals,
+// the intervals after them grow by the easiness factor within the first and the last review interval.
+// The zero review intervals keep the classic SM-2 intervals.
+//
+//	See: https://super-memory.com/english/ol/sm2.htm
+type SM2 struct {
+	intervals valueobject.ReviewInterval
+}
+
+func NewSM2(intervals valueobject.ReviewInterval) SM2 {
+	return SM2{intervals: intervals}
+}
+
+// step returns the first (0) or the second (1) interval.
+func (s SM2) step(i int) time.Duration {
+	if s.intervals.IsZero() {
+		return sm2Steps[i]
+	}
+	return s.intervals.At(i)
+}
+
+func (s SM2) Schedule(memory Memory, grade valueobject.Grade, now time.Time) Memory {
+	ease := memory.Ease
+	if ease == 0 {
+		ease = sm2DefaultEase
+	}
+
+	q := sm2Quality(grade)
+	if q < 3 {
+		memory.Repetitions = 0
+		memory.Interval = s.step(0)
+	} else {
+		switch memory.Repetitions {
+		case 0:
+			memory.Interval = s.step(0)
+		case 1:
+			memory.Interval = s.step(1)
+		default:
+			interval := memory.Interval
+			// an item without an interval grows from the second SM-2 interval, not from zero
+			if interval <= 0 {
+				interval = s.step(1)
+			}
+			memory.Interval = clampInterval(days(math.Round(interval.Hours()/24*ease)), s.intervals)
+		}
+		memory.Repetitions++
+	}
+
+	ease += 0.1 - float64(5-q)*(0.08+float64(5-q)*0.02)
+	memory.Ease = math.Max(ease, sm2MinEase)
+	memory.LastReviewedAt = now
+
+	return memory
+}
+
+// Relapse takes the given number of successful repetitions back, the interval
+// shrinks by the easiness factor per step down to the first SM-2 intervals.
+func (s SM2) Relapse(memory Memory, steps int, now time.Time) Memory {
+	ease := memory.Ease
+	if ease == 0 {
+		ease = sm2DefaultEase
+	}
+
+	repetitions := max(memory.Repetitions-steps, 0)
+	switch repetitions {
+	case 0, 1:
+		memory.Interval = s.step(0)
+	case 2:
+		memory.Interval = s.step(1)
+	default:
+		interval := days(math.Round(memory.Interval.Hours() / 24 / math.Pow(ease, float64(memory.Repetitions-repetitions))))
+		memory.Interval = clampInterval(max(interval, s.step(1)), s.intervals)
+	}
+	memory.Repetitions = repetitions
+	memory.Ease = ease
+	memory.LastReviewedAt = now
+
+	return memory
+}
+
+// sm2Quality maps the grade to the SM-2 response quality (0-5).
+func sm2Quality(grade valueobject.Grade) int {
+	switch grade {
+	case valueobject.GradeAgain:
+		return 1
+	case valueobject.GradeHard:
+		return 3
+	case valueobject.GradeGood:
+		return 4
+	case valueobject.GradeEasy:
+		return 5
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,138 @@
+package scheduler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestSM2_Schedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name   string
+		memory Memory
+		grade  valueobject.Grade
+		want   Memory
+	}{
+		{
+			name:   "With new item and good grade",
+			memory: Memory{},
+			grade:  valueobject.GradeGood,
+			want:   Memory{Ease: 2.5, Repetitions: 1, Interval: days(1), LastReviewedAt: now},
+		},
+		{
+			name:   "With second successful review",
+			memory: Memory{Ease: 2.5, Repetitions: 1, Interval: days(1), LastReviewedAt: now.Add(-day)},
+			grade:  valueobject.GradeEasy,
+			want:   Memory{Ease: 2.6, Repetitions: 2, Interval: days(6), LastReviewedAt: now},
+		},
+		{
+			name:   "With third successful review",
+			memory: Memory{Ease: 2.6, Repetitions: 2, Interval: days(6), LastReviewedAt: now.Add(-6 * day)},
+			grade:  valueobject.GradeGood,
+			want:   Memory{Ease: 2.6, Repetitions: 3, Interval: days(16), LastReviewedAt: now},
+		},
+		{
+			name:   "With repetitions without interval",
+			memory: Memory{Repetitions: 3},
+			grade:  valueobject.GradeGood,
+			want:   Memory{Ease: 2.5, Repetitions: 4, Interval: days(15), LastReviewedAt: now},
+		},
+		{
+			name:   "With hard grade",
+			memory: Memory{Ease: 2.5, Repetitions: 2, Interval: days(6), LastReviewedAt: now.Add(-6 * day)},
+			grade:  valueobject.GradeHard,
+			want:   Memory{Ease: 2.36, Repetitions: 3, Interval: days(15), LastReviewedAt: now},
+		},
+		{
+			name:   "With again grade",
+			memory: Memory{Ease: 2.5, Repetitions: 4, Interval: days(40), LastReviewedAt: now.Add(-40 * day)},
+			grade:  valueobject.GradeAgain,
+			want:   Memory{Ease: 1.96, Repetitions: 0, Interval: days(1), LastReviewedAt: now},
+		},
+		{
+			name:   "With minimum ease",
+			memory: Memory{Ease: 1.3, Repetitions: 4, Interval: days(40), LastReviewedAt: now.Add(-40 * day)},
+			grade:  valueobject.GradeAgain,
+			want:   Memory{Ease: 1.3, Repetitions: 0, Interval: days(1), LastReviewedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewSM2(valueobject.ReviewInterval{}).Schedule(tt.memory, tt.grade, now)
+
+			t.Run("Expect memory to be updated", func(t *testing.T) {
+				assert.InDelta(t, tt.want.Ease, got.Ease, 0.001)
+				assert.Equal(t, tt.want.Repetitions, got.Repetitions)
+				assert.Equal(t, tt.want.Interval, got.Interval)
+				assert.Equal(t, tt.want.LastReviewedAt, got.LastReviewedAt)
+			})
+		})
+	}
+}
+
+func TestSM2_Schedule_ReviewIntervals(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	intervals, err := valueobject.ParseReviewInterval("2d 5d 1w 2w 3w 1M 45d 2M 3M 4M 6M 9M 1y 18M 2y 3y 4M")
+	require.NoError(t, err)
+	sm2 := NewSM2(intervals)
+
+	tests := []struct {
+		name   string
+		memory Memory
+		grade  valueobject.Grade
+		want   time.Duration
+	}{
+		{
+			name:   "With new item",
+			memory: Memory{},
+			grade:  valueobject.GradeGood,
+			want:   days(2),
+		},
+		{
+			name:   "With second review",
+			memory: Memory{Ease: 2.5, Repetitions: 1, Interval: days(2)},
+			grade:  valueobject.GradeGood,
+			want:   days(5),
+		},
+		{
+			name:   "With third review",
+			memory: Memory{Ease: 2.5, Repetitions: 2, Interval: days(5)},
+			grade:  valueobject.GradeGood,
+			want:   days(13),
+		},
+		{
+			name:   "With again grade",
+			memory: Memory{Ease: 2.5, Repetitions: 4, Interval: days(40)},
+			grade:  valueobject.GradeAgain,
+			want:   days(2),
+		},
+		{
+			name:   "With interval over the last review interval",
+			memory: Memory{Ease: 2.5, Repetitions: 5, Interval: days(100)},
+			grade:  valueobject.GradeGood,
+			want:   intervals.At(intervals.Len() - 1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, sm2.Schedule(tt.memory, tt.grade, now).Interval)
+		})
+	}
+
+	t.Run("Expect relapse back to the second review interval", func(t *testing.T) {
+		got := sm2.Relapse(Memory{Ease: 2.5, Repetitions: 4, Interval: days(38)}, 2, now)
+		assert.Equal(t, days(5), got.Interval)
+	})
+}
@@ -0,0 +1,138 @@
+package user
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/mail"
+	"net/url"
+	"strings"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Channel is a way the user is notified about the due revise items.
+type Channel string
+
+const (
+	ChannelTelegram Channel = "telegram"
+	ChannelEmail    Channel = "email"
+	ChannelWebhook  Channel = "webhook"
+)
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelTelegram, ChannelEmail, ChannelWebhook:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseChannel parses the channel from its name: telegram, email or webhook.
+func ParseChannel(s string) (Channel, error) {
+	op := errs.Op("domain.user.parse_channel")
+	channel := Channel(strings.ToLower(strings.TrimSpace(s)))
+	if !channel.IsValid() {
+		return "", errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid channel").
+			WithMessages([]errs.Message{{Key: "message", Value: "channel must be one of: telegram, email, webhook"}}).
+			WithContext("channel", s)
+	}
+	return channel, nil
+}
+
+const (
+	// WebhookSecretPrefix starts every webhook secret, so the secrets leaked into code or logs are easy to find.
+	WebhookSecretPrefix = "whsec_"
+	// webhookSecretLength is the number of the random bytes of a webhook secret.
+	webhookSecretLength = 32
+)
+
+// NewWebhookSecret generates the secret signing the webhook payloads of a user,
+// it is shown to the user once when the webhook channel is turned on.
+func NewWebhookSecret() (string, error) {
+	op := errs.Op("domain.user.new_webhook_secret")
+	secret := make([]byte, webhookSecretLength)
+	if _, err := rand.Read(secret); err != nil {
+		return "", errs.NewUnknownError(op, err, "failed to generate webhook secret")
+	}
+	return WebhookSecretPrefix + hex.EncodeToString(secret), nil
+}
+
+// DefaultChannels returns the channels of a new user.
+func DefaultChannels() []Channel {
+	return []Channel{ChannelTelegram}
+}
+
+func validateChannels(channels []Channel) error {
+	op := errs.Op("domain.user.validate_channels")
+	if len(channels) == 0 {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "no channels").
+			WithMessages([]errs.Message{{Key: "message", Value: "at least one notification channel is required"}})
+	}
+	seen := make(map[Channel]struct{}, len(channels))
+	for _, channel := range channels {
+		if !channel.IsValid() {
+			return errs.
+				NewIncorrectInputError(op, ErrInvalidSettings, "invalid channel").
+				WithMessages([]errs.Message{{Key: "message", Value: "channel must be one of: telegram, email, webhook"}}).
+				WithContext("channel", channel)
+		}
+		if _, ok := seen[channel]; ok {
+			return errs.
+				NewIncorrectInputError(op, ErrInvalidSettings, "duplicate channel").
+				WithMessages([]errs.Message{{Key: "message", Value: "notification channels must be unique"}}).
+				WithContext("channel", channel)
+		}
+		seen[channel] = struct{}{}
+	}
+	return nil
+}
+
+// validateEmail validates the email address, an empty address means none.
+func validateEmail(email string) error {
+	op := errs.Op("domain.user.validate_email")
+	if email == "" {
+		return nil
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid email").
+			WithMessages([]errs.Message{{Key: "message", Value: "email must be a plain address like name@example.com"}}).
+			WithContext("email", email)
+	}
+	return nil
+}
+
+// validateWebhookURL validates the webhook url, an empty url means none.
+// Only https is accepted, the payloads carry the revise items of the user.
+func validateWebhookURL(webhookURL string) error {
+	op := errs.Op("domain.user.validate_webhook_url")
+	if webhookURL == "" {
+		return nil
+	}
+	u, err := url.Parse(webhookURL)
+	if err != nil || u.Scheme != "https" || u.Hostname() == "" {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid webhook url").
+			WithMessages([]errs.Message{{Key: "message", Value: "webhook url must be an absolute https url"}}).
+			WithContext("webhook_url", webhookURL)
+	}
+	return nil
+}
+
+// validateWebhookSecret validates the webhook secret, an empty secret means none.
+func validateWebhookSecret(secret string) error {
+	op := errs.Op("domain.user.validate_webhook_secret")
+	if secret == "" {
+		return nil
+	}
+	if !strings.HasPrefix(secret, WebhookSecretPrefix) || len(secret) < len(WebhookSecretPrefix)+webhookSecretLength {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid webhook secret").
+			WithMessages([]errs.Message{{Key: "message", Value: "webhook secret is invalid"}})
+	}
+	return nil
+}
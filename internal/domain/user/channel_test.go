@@ -0,0 +1,93 @@
+package user
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/ARUMANDESU/go-revise/pkg/pointers"
+)
+
+func TestNewSettings_Channels(t *testing.T) {
+	t.Parallel()
+
+	webhookSecret, err := NewWebhookSecret()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		options     []SettingsOption
+		errExpected bool
+	}{
+		{name: "With default channels"},
+		{
+			name:    "With email channel and address",
+			options: []SettingsOption{WithChannels(ChannelTelegram, ChannelEmail), WithEmail("learner@example.com")},
+		},
+		{
+			name: "With webhook channel and url",
+			options: []SettingsOption{
+				WithWebhookURL("https://example.com/hooks"),
+				WithWebhookSecret(webhookSecret),
+				WithChannels(ChannelWebhook),
+			},
+		},
+		{
+			name:    "With webhook url and channel off",
+			options: []SettingsOption{WithWebhookURL("https://example.com/hooks")},
+		},
+		{
+			name:        "With webhook channel without secret",
+			options:     []SettingsOption{WithWebhookURL("https://example.com/hooks"), WithChannels(ChannelWebhook)},
+			errExpected: true,
+		},
+		{name: "With invalid webhook secret", options: []SettingsOption{WithWebhookSecret("s3cret")}, errExpected: true},
+		{name: "With email channel without address", options: []SettingsOption{WithChannels(ChannelEmail)}, errExpected: true},
+		{name: "With webhook channel without url", options: []SettingsOption{WithChannels(ChannelWebhook)}, errExpected: true},
+		{name: "With no channels", options: []SettingsOption{WithChannels()}, errExpected: true},
+		{name: "With duplicate channels", options: []SettingsOption{WithChannels(ChannelTelegram, ChannelTelegram)}, errExpected: true},
+		{name: "With unknown channel", options: []SettingsOption{WithChannels("pigeon")}, errExpected: true},
+		{name: "With invalid email", options: []SettingsOption{WithEmail("Learner <learner@example.com>")}, errExpected: true},
+		{name: "With relative webhook url", options: []SettingsOption{WithWebhookURL("/hooks")}, errExpected: true},
+		{name: "With ftp webhook url", options: []SettingsOption{WithWebhookURL("ftp://example.com/hooks")}, errExpected: true},
+		{name: "With http webhook url", options: []SettingsOption{WithWebhookURL("http://example.com/hooks")}, errExpected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := NewSettings(pointers.New(language.English), DefaultReminderTime(), tt.options...)
+			if tt.errExpected {
+				require.ErrorIs(t, err, ErrInvalidSettings)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, settings.Validate())
+		})
+	}
+}
+
+func TestParseChannel(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseChannel(" Email ")
+	require.NoError(t, err)
+	require.Equal(t, ChannelEmail, got)
+
+	_, err = ParseChannel("sms")
+	require.ErrorIs(t, err, ErrInvalidSettings)
+}
+
+func TestNewWebhookSecret(t *testing.T) {
+	t.Parallel()
+
+	secret, err := NewWebhookSecret()
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(secret, WebhookSecretPrefix))
+	require.NoError(t, validateWebhookSecret(secret))
+
+	other, err := NewWebhookSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+}
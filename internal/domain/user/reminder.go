@@ -0,0 +1,209 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// MaxReminderSlots limits how many reminders a user can get per day.
+const MaxReminderSlots = 6
+
+// WeekdayMask is a set of weekdays, bit 0 is Sunday and bit 6 is Saturday as in time.Weekday.
+type WeekdayMask uint8
+
+const (
+	EveryDay WeekdayMask = 1<<7 - 1
+	Weekends WeekdayMask = 1<<time.Saturday | 1<<time.Sunday
+	Workdays WeekdayMask = EveryDay &^ Weekends
+)
+
+var weekdayNames = [7]string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// Has reports whether the weekday is in the mask.
+func (m WeekdayMask) Has(day time.Weekday) bool {
+	return m&(1<<day) != 0
+}
+
+func (m WeekdayMask) String() string {
+	switch m {
+	case EveryDay:
+		return "daily"
+	case Workdays:
+		return "workdays"
+	case Weekends:
+		return "weekends"
+	}
+
+	days := make([]string, 0, len(weekdayNames))
+	// start the week from Monday, Sunday goes last
+	for i := 1; i <= len(weekdayNames); i++ {
+		day := time.Weekday(i % len(weekdayNames))
+		if m.Has(day) {
+			days = append(days, weekdayNames[day])
+		}
+	}
+	return strings.Join(days, ",")
+}
+
+// ParseWeekdayMask parses comma separated weekdays, e.g. "mon,wed,fri",
+// or one of "daily", "workdays" and "weekends". An empty string means every day.
+func ParseWeekdayMask(s string) (WeekdayMask, error) {
+	op := errs.Op("domain.user.parse_weekday_mask")
+	s = strings.ToLower(strings.TrimSpace(s))
+	switch s {
+	case "", "daily":
+		return EveryDay, nil
+	case "workdays":
+		return Workdays, nil
+	case "weekends":
+		return Weekends, nil
+	}
+
+	var mask WeekdayMask
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		found := false
+		for day, dayName := range weekdayNames {
+			if strings.HasPrefix(name, dayName) {
+				mask |= 1 << day
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, errs.
+				NewIncorrectInputError(op, ErrInvalidSettings, "invalid weekday").
+				WithMessages([]errs.Message{{Key: "message", Value: "weekdays must be like mon,wed,fri or one of daily, workdays, weekends"}}).
+				WithContext("weekday", name)
+		}
+	}
+	return mask, nil
+}
+
+// ReminderSlot is a time of day when a reminder is sent on the selected weekdays.
+type ReminderSlot struct {
+	Time     ReminderTime
+	Weekdays WeekdayMask
+}
+
+// DefaultReminderSlot returns a reminder at the default reminder time every day.
+func DefaultReminderSlot() ReminderSlot {
+	return ReminderSlot{Time: DefaultReminderTime(), Weekdays: EveryDay}
+}
+
+func (s ReminderSlot) Validate() error {
+	op := errs.Op("domain.user.reminder_slot.validate")
+	if err := s.Time.Validate(); err != nil {
+		return errs.WithOp(op, err, "reminder time is invalid")
+	}
+	if s.Weekdays == 0 || s.Weekdays&^EveryDay != 0 {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "weekdays are invalid").
+			WithMessages([]errs.Message{{Key: "message", Value: "reminder must be sent on at least one weekday"}}).
+			WithContext("weekdays", uint8(s.Weekdays))
+	}
+	return nil
+}
+
+// Matches reports whether the reminder is due at the given local time.
+func (s ReminderSlot) Matches(local time.Time) bool {
+	return s.Weekdays.Has(local.Weekday()) &&
+		int(s.Time.Hour) == local.Hour() &&
+		int(s.Time.Minute) == local.Minute()
+}
+
+func (s ReminderSlot) String() string {
+	if s.Weekdays == EveryDay {
+		return s.Time.String()
+	}
+	return fmt.Sprintf("%s@%s", s.Time, s.Weekdays)
+}
+
+// ParseReminderSlot parses a reminder slot in the form of "hh:mm" or "hh:mm@weekdays", e.g. 21:00@workdays.
+func ParseReminderSlot(s string) (ReminderSlot, error) {
+	op := errs.Op("domain.user.parse_reminder_slot")
+	timePart, weekdaysPart, _ := strings.Cut(strings.TrimSpace(s), "@")
+
+	rt, err := ParseReminderTime(timePart)
+	if err != nil {
+		return ReminderSlot{}, errs.WithOp(op, err, "failed to parse reminder time")
+	}
+	weekdays, err := ParseWeekdayMask(weekdaysPart)
+	if err != nil {
+		return ReminderSlot{}, errs.WithOp(op, err, "failed to parse weekdays")
+	}
+	return ReminderSlot{Time: rt, Weekdays: weekdays}, nil
+}
+
+// QuietHours is a window of the day in which no reminders are sent.
+// The window may wrap around midnight, e.g. 22:00-07:00.
+// The zero value, where start is equal to end, means no quiet hours.
+type QuietHours struct {
+	Start ReminderTime
+	End   ReminderTime
+}
+
+// IsSet reports whether the quiet hours are configured.
+func (q QuietHours) IsSet() bool {
+	return q.Start != q.End
+}
+
+func (q QuietHours) Validate() error {
+	op := errs.Op("domain.user.quiet_hours.validate")
+	if err := q.Start.Validate(); err != nil {
+		return errs.WithOp(op, err, "quiet hours start is invalid")
+	}
+	if err := q.End.Validate(); err != nil {
+		return errs.WithOp(op, err, "quiet hours end is invalid")
+	}
+	return nil
+}
+
+// Contains reports whether the given local time is within the quiet hours, the end is exclusive.
+func (q QuietHours) Contains(local time.Time) bool {
+	if !q.IsSet() {
+		return false
+	}
+	minute := local.Hour()*60 + local.Minute()
+	start, end := q.Start.minuteOfDay(), q.End.minuteOfDay()
+	if start < end {
+		return start <= minute && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+func (q QuietHours) String() string {
+	if !q.IsSet() {
+		return "off"
+	}
+	return fmt.Sprintf("%s-%s", q.Start, q.End)
+}
+
+// ParseQuietHours parses quiet hours in the form of "hh:mm-hh:mm", "off" or an empty string means no quiet hours.
+func ParseQuietHours(s string) (QuietHours, error) {
+	op := errs.Op("domain.user.parse_quiet_hours")
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, "off") {
+		return QuietHours{}, nil
+	}
+
+	startPart, endPart, found := strings.Cut(s, "-")
+	if !found {
+		return QuietHours{}, errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid quiet hours").
+			WithMessages([]errs.Message{{Key: "message", Value: "quiet hours must be like 22:00-07:00"}}).
+			WithContext("quiet_hours", s)
+	}
+	start, err := ParseReminderTime(startPart)
+	if err != nil {
+		return QuietHours{}, errs.WithOp(op, err, "failed to parse quiet hours start")
+	}
+	end, err := ParseReminderTime(endPart)
+	if err != nil {
+		return QuietHours{}, errs.WithOp(op, err, "failed to parse quiet hours end")
+	}
+	return QuietHours{Start: start, End: end}, nil
+}
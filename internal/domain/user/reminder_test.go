@@ -0,0 +1,220 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+func TestParseReminderSlot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       string
+		want        ReminderSlot
+		errExpected bool
+	}{
+		{name: "With time only", input: "7:30", want: ReminderSlot{Time: ReminderTime{7, 30}, Weekdays: EveryDay}},
+		{name: "With workdays", input: "21:00@workdays", want: ReminderSlot{Time: ReminderTime{21, 0}, Weekdays: Workdays}},
+		{
+			name:  "With weekday list",
+			input: "08:15@mon,wed,fri",
+			want: ReminderSlot{
+				Time:     ReminderTime{8, 15},
+				Weekdays: 1<<time.Monday | 1<<time.Wednesday | 1<<time.Friday,
+			},
+		},
+		{name: "With invalid time", input: "25:00", errExpected: true},
+		{name: "With invalid weekday", input: "07:00@someday", errExpected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReminderSlot(tt.input)
+			if tt.errExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			reparsed, err := ParseReminderSlot(got.String())
+			require.NoError(t, err)
+			assert.Equal(t, got, reparsed)
+		})
+	}
+}
+
+func TestQuietHours_Contains(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 10, 1, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name       string
+		quietHours string
+		t          time.Time
+		want       bool
+	}{
+		{name: "With no quiet hours", quietHours: "off", t: at(23, 0), want: false},
+		{name: "Within the same day window", quietHours: "13:00-15:00", t: at(14, 0), want: true},
+		{name: "At the end of the window", quietHours: "13:00-15:00", t: at(15, 0), want: false},
+		{name: "Before midnight in a wrapping window", quietHours: "22:00-07:00", t: at(23, 30), want: true},
+		{name: "After midnight in a wrapping window", quietHours: "22:00-07:00", t: at(6, 59), want: true},
+		{name: "Outside a wrapping window", quietHours: "22:00-07:00", t: at(7, 0), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := ParseQuietHours(tt.quietHours)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, q.Contains(tt.t))
+		})
+	}
+}
+
+func TestSettings_LatestReminder(t *testing.T) {
+	t.Parallel()
+
+	// 2024-10-05 is a Saturday
+	saturdayEvening := time.Date(2024, 10, 5, 21, 30, 0, 0, time.UTC)
+	morning := ReminderSlot{Time: ReminderTime{7, 0}, Weekdays: EveryDay}
+	evening := ReminderSlot{Time: ReminderTime{21, 0}, Weekdays: EveryDay}
+
+	tests := []struct {
+		name    string
+		options []SettingsOption
+		after   time.Time
+		want    time.Time
+	}{
+		{
+			name:    "With the evening slot passed",
+			options: []SettingsOption{WithReminderSlots(morning, evening)},
+			after:   saturdayEvening.Add(-time.Hour),
+			want:    time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "With the evening slot already notified",
+			options: []SettingsOption{WithReminderSlots(morning, evening)},
+			after:   time.Date(2024, 10, 5, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "With missed slots on previous days",
+			options: []SettingsOption{WithReminderSlots(morning)},
+			after:   saturdayEvening.AddDate(0, 0, -3),
+			want:    time.Date(2024, 10, 5, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "With a workdays slot on a weekend",
+			options: []SettingsOption{
+				WithReminderSlots(ReminderSlot{Time: ReminderTime{21, 0}, Weekdays: Workdays}),
+			},
+			after: saturdayEvening.AddDate(0, 0, -3),
+			want:  time.Date(2024, 10, 4, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "With the slot in quiet hours",
+			options: []SettingsOption{
+				WithReminderSlots(evening),
+				WithQuietHours(QuietHours{Start: ReminderTime{20, 0}, End: ReminderTime{8, 0}}),
+			},
+			after: saturdayEvening.AddDate(0, 0, -1),
+		},
+		{
+			name:    "With the slot in the user's time zone",
+			options: []SettingsOption{WithReminderSlots(evening), WithTimezone(mustParseTimezone(t, "Asia/Almaty"))},
+			after:   saturdayEvening.Add(-24 * time.Hour),
+			want:    time.Date(2024, 10, 5, 16, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "With no slots",
+			options: []SettingsOption{WithReminderSlots()},
+			after:   saturdayEvening.AddDate(0, 0, -1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lang := DefaultLanguage()
+			s, err := NewSettings(&lang, DefaultReminderTime(), tt.options...)
+			require.NoError(t, err)
+
+			got, ok := s.LatestReminder(tt.after, saturdayEvening)
+			assert.Equal(t, !tt.want.IsZero(), ok)
+			if ok {
+				assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestUser_DueReminder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 10, 5, 21, 30, 0, 0, time.UTC)
+	lang := DefaultLanguage()
+	settings, err := NewSettings(&lang, ReminderTime{21, 0})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		createdAt      time.Time
+		lastNotifiedAt time.Time
+		catchUp        time.Duration
+		wantDue        bool
+	}{
+		{name: "With the slot missed", createdAt: now.AddDate(0, -1, 0), lastNotifiedAt: now.AddDate(0, 0, -1), catchUp: time.Hour, wantDue: true},
+		{name: "With the slot notified", createdAt: now.AddDate(0, -1, 0), lastNotifiedAt: now.Add(-30 * time.Minute), catchUp: time.Hour},
+		{name: "With the slot before the registration", createdAt: now.Add(-10 * time.Minute), catchUp: time.Hour},
+		{name: "With the slot missed longer than catch up", createdAt: now.AddDate(0, -1, 0), catchUp: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := MustNewUser(
+				NewUserID(),
+				123456789,
+				WithSettings(settings),
+				WithCreatedAt(tt.createdAt),
+				WithLastNotifiedAt(tt.lastNotifiedAt),
+			)
+
+			_, due := u.DueReminder(now, tt.catchUp)
+			assert.Equal(t, tt.wantDue, due)
+		})
+	}
+}
+
+func mustParseTimezone(t *testing.T, name string) valueobject.Timezone {
+	t.Helper()
+
+	tz, err := valueobject.ParseTimezone(name)
+	require.NoError(t, err)
+	return tz
+}
+
+func TestWithReminderSlots_Validation(t *testing.T) {
+	t.Parallel()
+
+	lang := DefaultLanguage()
+	slot := DefaultReminderSlot()
+
+	_, err := NewSettings(&lang, DefaultReminderTime(), WithReminderSlots(slot, slot))
+	assert.ErrorIs(t, err, ErrInvalidSettings, "duplicate slots are not allowed")
+
+	_, err = NewSettings(&lang, DefaultReminderTime(), WithReminderSlots(ReminderSlot{Time: slot.Time}))
+	assert.ErrorIs(t, err, ErrInvalidSettings, "slot without weekdays is not allowed")
+
+	tooMany := make([]ReminderSlot, 0, MaxReminderSlots+1)
+	for i := 0; i <= MaxReminderSlots; i++ {
+		tooMany = append(tooMany, ReminderSlot{Time: ReminderTime{uint8(i), 0}, Weekdays: EveryDay})
+	}
+	_, err = NewSettings(&lang, DefaultReminderTime(), WithReminderSlots(tooMany...))
+	assert.ErrorIs(t, err, ErrInvalidSettings, "too many slots are not allowed")
+}
@@ -9,11 +9,13 @@ import (
 	"time"
 
 	"github.com/gofrs/uuid"
+	"golang.org/x/text/language"
 
 	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqlc"
 	"github.com/ARUMANDESU/go-revise/internal/adapters/db/sqliterr"
 	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
 	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 	"github.com/ARUMANDESU/go-revise/pkg/logutil"
 	"github.com/ARUMANDESU/go-revise/pkg/pointers"
@@ -27,30 +29,38 @@ func NewSQLiteRepo(db *sql.DB) SQLiteRepo {
 	return SQLiteRepo{db: db}
 }
 
-// CreateUser creates a new user.
+// CreateUser creates a new user along with the reminder slots.
 func (r *SQLiteRepo) CreateUser(ctx context.Context, u user.User) (_ error) {
 	op := errs.Op("domain.user.sqlite.create_user")
 
-	reminderTime := fmt.Sprintf(
-		"%d:%d",
-		u.Settings().ReminderTime.Hour,
-		u.Settings().ReminderTime.Minute,
-	)
+	quietHoursStart, quietHoursEnd := quietHoursToModel(u.Settings().QuietHours)
 	params := sqlc.CreateUserParams{
-		ID:           u.ID().String(),
-		ChatID:       int64(u.ChatID()),
-		CreatedAt:    u.CreatedAt(),
-		UpdatedAt:    u.UpdatedAt(),
-		Language:     sql.NullString{String: u.Settings().Language.String(), Valid: true},
-		ReminderTime: reminderTime,
+		ID:                u.ID().String(),
+		ChatID:            int64(u.ChatID()),
+		CreatedAt:         u.CreatedAt(),
+		UpdatedAt:         u.UpdatedAt(),
+		Language:          sql.NullString{String: u.Settings().Language.String(), Valid: true},
+		ReviewIntervals:   reviewIntervalsToModel(u.Settings().ReviewIntervals),
+		DailyReviewLimit:  int64(u.Settings().DailyQuota.Reviews),
+		DailyNewItemLimit: int64(u.Settings().DailyQuota.NewItems),
+		Timezone:          u.Settings().Timezone.String(),
+		QuietHoursStart:   quietHoursStart,
+		QuietHoursEnd:     quietHoursEnd,
+
+		NotificationChannels: channelsToModel(u.Settings().Channels),
+		Email:                stringToNullString(u.Settings().Email),
+		WebhookURL:           stringToNullString(u.Settings().WebhookURL),
+		WebhookSecret:        stringToNullString(u.Settings().WebhookSecret),
 	}
 
-	err := sqlc.New(r.db).CreateUser(ctx, params)
-	if err != nil {
-		return sqliterr.Handle(op, err, "failed to create user").WithContext("user", u)
-	}
+	return r.withTx(ctx, op, func(q *sqlc.Queries) error {
+		err := q.CreateUser(ctx, params)
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to create user").WithContext("user", u)
+		}
 
-	return nil
+		return createReminderSlots(ctx, op, q, u.ID(), u.Settings().ReminderSlots)
+	})
 }
 
 func (r *SQLiteRepo) withTx(ctx context.Context, op errs.Op, fn func(*sqlc.Queries) error) error {
@@ -94,9 +104,9 @@ func (r *SQLiteRepo) UpdateUser(
 			return sqliterr.Handle(op, err, "failed to get user by id").WithContext("id", userID)
 		}
 
-		domainUser, err := modelToUser(userModel)
+		domainUser, err := loadUser(ctx, q, userModel)
 		if err != nil {
-			return errs.WithOp(op, err, "failed to convert model to user")
+			return errs.WithOp(op, err, "failed to load user")
 		}
 
 		domainUser, err = updateFn(domainUser)
@@ -106,37 +116,65 @@ func (r *SQLiteRepo) UpdateUser(
 
 		userModel = userToModel(domainUser)
 		err = q.UpdateUser(ctx, sqlc.UpdateUserParams{
-			UpdatedAt:    userModel.UpdatedAt,
-			Language:     userModel.Language,
-			ReminderTime: userModel.ReminderTime,
-			ID:           userModel.ID,
+			UpdatedAt:         userModel.UpdatedAt,
+			Language:          userModel.Language,
+			ReviewIntervals:   userModel.ReviewIntervals,
+			DailyReviewLimit:  userModel.DailyReviewLimit,
+			DailyNewItemLimit: userModel.DailyNewItemLimit,
+			Timezone:          userModel.Timezone,
+			QuietHoursStart:   userModel.QuietHoursStart,
+			QuietHoursEnd:     userModel.QuietHoursEnd,
+
+			NotificationChannels: userModel.NotificationChannels,
+			Email:                userModel.Email,
+			WebhookURL:           userModel.WebhookURL,
+			WebhookSecret:        userModel.WebhookSecret,
+			ID:                   userModel.ID,
 		})
 		if err != nil {
 			return sqliterr.Handle(op, err, "failed to update user")
 		}
 
-		return nil
+		// the slots are replaced as a whole, there are only a few of them per user
+		err = q.DeleteUserReminderSlots(ctx, userModel.ID)
+		if err != nil {
+			return sqliterr.Handle(op, err, "failed to delete reminder slots").WithContext("id", userID)
+		}
+		return createReminderSlots(ctx, op, q, domainUser.ID(), domainUser.Settings().ReminderSlots)
 	})
 }
 
+// GetUsersForNotification returns the users with at least one reminder slot,
+// along with the time they were last notified at, to find out which reminders are due.
 func (r *SQLiteRepo) GetUsersForNotification(ctx context.Context) ([]user.User, error) {
 	op := errs.Op("domain.user.sqlite.get_users_for_notification")
 	q := sqlc.New(r.db)
 
-	reminderTimeModel := reminderTimeToModel(user.ReminderTime{
-		Hour:   uint8(time.Now().Hour()),
-		Minute: uint8(time.Now().Minute()),
-	})
-	userModels, err := q.GetUsersByReminderTime(ctx, reminderTimeModel)
+	userModels, err := q.ListUsersWithReminderSlots(ctx)
 	if err != nil {
-		return nil, sqliterr.
-			Handle(op, err, "failed to get users by reminder time").
-			WithContext("reminder_time", reminderTimeModel)
+		return nil, sqliterr.Handle(op, err, "failed to list users with reminder slots")
 	}
 
-	users, err := modelsToUsers(userModels)
+	// load all the slots at once instead of a query per user
+	slotModels, err := q.ListReminderSlots(ctx)
 	if err != nil {
-		return nil, errs.WithOp(op, err, "failed to convert models to users")
+		return nil, sqliterr.Handle(op, err, "failed to list reminder slots")
+	}
+	slotsByUser := make(map[string][]sqlc.UserReminderSlot, len(userModels))
+	for _, slot := range slotModels {
+		slotsByUser[slot.UserID] = append(slotsByUser[slot.UserID], slot)
+	}
+
+	users := make([]user.User, 0, len(userModels))
+	for _, model := range userModels {
+		domainUser, err := modelToUser(model, slotsByUser[model.ID])
+		if err != nil {
+			slog.Warn("skipping user with invalid settings",
+				slog.String("user", model.ID),
+				logutil.Err(err))
+			continue
+		}
+		users = append(users, *domainUser)
 	}
 	return users, nil
 }
@@ -152,7 +190,14 @@ func (r *SQLiteRepo) GetUserByID(ctx context.Context, id uuid.UUID) (query.User,
 			WithContext("id", id)
 	}
 
-	queryUser, err := modelToQueryUser(userModel)
+	slotModels, err := q.ListUserReminderSlots(ctx, userModel.ID)
+	if err != nil {
+		return query.User{}, sqliterr.
+			Handle(op, err, "failed to list reminder slots").
+			WithContext("id", userModel.ID)
+	}
+
+	queryUser, err := modelToQueryUser(userModel, slotModels)
 	if err != nil {
 		return query.User{}, errs.WithOp(op, err, "failed to convert model to query user")
 	}
@@ -173,13 +218,39 @@ func (r *SQLiteRepo) GetUserByChatID(
 			WithContext("chat_id", chatID)
 	}
 
-	queryUser, err := modelToQueryUser(userModel)
+	slotModels, err := q.ListUserReminderSlots(ctx, userModel.ID)
+	if err != nil {
+		return query.User{}, sqliterr.
+			Handle(op, err, "failed to list reminder slots").
+			WithContext("id", userModel.ID)
+	}
+
+	queryUser, err := modelToQueryUser(userModel, slotModels)
 	if err != nil {
 		return query.User{}, errs.WithOp(op, err, "failed to convert model to query user")
 	}
 	return queryUser, nil
 }
 
+// GetUser returns the domain user by id.
+func (r *SQLiteRepo) GetUser(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	op := errs.Op("domain.user.sqlite.get_user")
+	q := sqlc.New(r.db)
+
+	userModel, err := q.GetUserByID(ctx, id.String())
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to get user by id").
+			WithContext("id", id)
+	}
+
+	domainUser, err := loadUser(ctx, q, userModel)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to load user")
+	}
+	return domainUser, nil
+}
+
 func (r *SQLiteRepo) GetUserByTelegramID(
 	ctx context.Context,
 	id user.TelegramID,
@@ -194,34 +265,175 @@ func (r *SQLiteRepo) GetUserByTelegramID(
 			WithContext("chat_id", id)
 	}
 
-	domainUser, err := modelToUser(userModel)
+	domainUser, err := loadUser(ctx, q, userModel)
 	if err != nil {
-		return nil, errs.WithOp(op, err, "failed to convert model to user")
+		return nil, errs.WithOp(op, err, "failed to load user")
 	}
 	return domainUser, nil
 }
 
+// GetUserReviewIntervals returns the review intervals configured by the user.
+func (r *SQLiteRepo) GetUserReviewIntervals(
+	ctx context.Context,
+	userID uuid.UUID,
+) (valueobject.ReviewInterval, error) {
+	op := errs.Op("domain.user.sqlite.get_user_review_intervals")
+	q := sqlc.New(r.db)
+
+	userModel, err := q.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return valueobject.ReviewInterval{}, sqliterr.
+			Handle(op, err, "failed to get user by id").
+			WithContext("id", userID)
+	}
+
+	intervals, err := modelToReviewIntervals(userModel.ReviewIntervals)
+	if err != nil {
+		return valueobject.ReviewInterval{}, errs.WithOp(op, err, "failed to convert review intervals")
+	}
+	return intervals, nil
+}
+
+// GetUserDailyQuota returns the daily quota configured by the user.
+func (r *SQLiteRepo) GetUserDailyQuota(
+	ctx context.Context,
+	userID uuid.UUID,
+) (valueobject.DailyQuota, error) {
+	op := errs.Op("domain.user.sqlite.get_user_daily_quota")
+	q := sqlc.New(r.db)
+
+	userModel, err := q.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return valueobject.DailyQuota{}, sqliterr.
+			Handle(op, err, "failed to get user by id").
+			WithContext("id", userID)
+	}
+	return modelToDailyQuota(userModel), nil
+}
+
+// GetUserTimezone returns the time zone of the user.
+func (r *SQLiteRepo) GetUserTimezone(ctx context.Context, userID uuid.UUID) (valueobject.Timezone, error) {
+	op := errs.Op("domain.user.sqlite.get_user_timezone")
+	q := sqlc.New(r.db)
+
+	userModel, err := q.GetUserByID(ctx, userID.String())
+	if err != nil {
+		return valueobject.Timezone{}, sqliterr.
+			Handle(op, err, "failed to get user by id").
+			WithContext("id", userID)
+	}
+
+	tz, err := valueobject.ParseTimezone(userModel.Timezone)
+	if err != nil {
+		return valueobject.Timezone{}, errs.WithOp(op, err, "failed to convert timezone")
+	}
+	return tz, nil
+}
+
 func userToModel(u *user.User) sqlc.User {
+	quietHoursStart, quietHoursEnd := quietHoursToModel(u.Settings().QuietHours)
 	return sqlc.User{
-		ID:           u.ID().String(),
-		ChatID:       int64(u.ChatID()),
-		CreatedAt:    u.CreatedAt(),
-		UpdatedAt:    u.UpdatedAt(),
-		Language:     sql.NullString{String: u.Settings().Language.String(), Valid: true},
-		ReminderTime: reminderTimeToModel(u.Settings().ReminderTime),
+		ID:                u.ID().String(),
+		ChatID:            int64(u.ChatID()),
+		CreatedAt:         u.CreatedAt(),
+		UpdatedAt:         u.UpdatedAt(),
+		Language:          sql.NullString{String: u.Settings().Language.String(), Valid: true},
+		ReviewIntervals:   reviewIntervalsToModel(u.Settings().ReviewIntervals),
+		DailyReviewLimit:  int64(u.Settings().DailyQuota.Reviews),
+		DailyNewItemLimit: int64(u.Settings().DailyQuota.NewItems),
+		Timezone:          u.Settings().Timezone.String(),
+		QuietHoursStart:   quietHoursStart,
+		QuietHoursEnd:     quietHoursEnd,
+
+		NotificationChannels: channelsToModel(u.Settings().Channels),
+		Email:                stringToNullString(u.Settings().Email),
+		WebhookURL:           stringToNullString(u.Settings().WebhookURL),
+		WebhookSecret:        stringToNullString(u.Settings().WebhookSecret),
+	}
+}
+
+// loadUser loads the reminder slots of the user model and converts them to the domain user.
+func loadUser(ctx context.Context, q *sqlc.Queries, u sqlc.User) (*user.User, error) {
+	op := errs.Op("domain.user.sqlite.load_user")
+
+	slotModels, err := q.ListUserReminderSlots(ctx, u.ID)
+	if err != nil {
+		return nil, sqliterr.
+			Handle(op, err, "failed to list reminder slots").
+			WithContext("id", u.ID)
+	}
+
+	domainUser, err := modelToUser(u, slotModels)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert model to user")
+	}
+	return domainUser, nil
+}
+
+func createReminderSlots(
+	ctx context.Context,
+	op errs.Op,
+	q *sqlc.Queries,
+	userID uuid.UUID,
+	slots []user.ReminderSlot,
+) error {
+	for _, slot := range slots {
+		err := q.CreateUserReminderSlot(ctx, sqlc.CreateUserReminderSlotParams{
+			UserID:       userID.String(),
+			ReminderTime: reminderTimeToModel(slot.Time),
+			Weekdays:     int64(slot.Weekdays),
+		})
+		if err != nil {
+			return sqliterr.
+				Handle(op, err, "failed to create reminder slot").
+				WithContext("id", userID).
+				WithContext("reminder_slot", slot.String())
+		}
 	}
+	return nil
 }
 
-func modelToUser(u sqlc.User) (*user.User, error) {
+func modelToUser(u sqlc.User, slotModels []sqlc.UserReminderSlot) (*user.User, error) {
 	op := errs.Op("domain.user.sqlite.model_to_user")
 
-	reminderTime, err := modelToReminderTime(u.ReminderTime)
+	slots, err := modelsToReminderSlots(slotModels)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert reminder slots")
+	}
+
+	quietHours, err := modelToQuietHours(u)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert quiet hours")
+	}
+
+	reviewIntervals, err := modelToReviewIntervals(u.ReviewIntervals)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert review intervals")
+	}
+
+	timezone, err := valueobject.ParseTimezone(u.Timezone)
 	if err != nil {
-		return nil, errs.WithOp(op, err, "failed to convert reminder time")
+		return nil, errs.WithOp(op, err, "failed to convert timezone")
 	}
 
-	// @@TODO: change language from default one to the one from the database, i did not figure out how to do it
-	settings, err := user.NewSettings(pointers.New(user.DefaultLanguage()), reminderTime)
+	channels, err := modelToChannels(u.NotificationChannels)
+	if err != nil {
+		return nil, errs.WithOp(op, err, "failed to convert notification channels")
+	}
+
+	settings, err := user.NewSettings(
+		pointers.New(modelToLanguage(u.Language)),
+		user.DefaultReminderTime(),
+		user.WithReminderSlots(slots...),
+		user.WithQuietHours(quietHours),
+		user.WithReviewIntervals(reviewIntervals),
+		user.WithDailyQuota(modelToDailyQuota(u)),
+		user.WithTimezone(timezone),
+		user.WithChannels(channels...),
+		user.WithEmail(u.Email.String),
+		user.WithWebhookURL(u.WebhookURL.String),
+		user.WithWebhookSecret(u.WebhookSecret.String),
+	)
 	if err != nil {
 		return nil, errs.WithOp(op, err, "failed to create settings")
 	}
@@ -232,6 +444,7 @@ func modelToUser(u sqlc.User) (*user.User, error) {
 		user.WithCreatedAt(u.CreatedAt),
 		user.WithUpdatedAt(u.UpdatedAt),
 		user.WithSettings(settings),
+		user.WithLastNotifiedAt(modelToLastNotifiedAt(u.LastNotifiedAt)),
 	)
 	if err != nil {
 		return nil, errs.WithOp(op, err, "failed to create user")
@@ -239,51 +452,56 @@ func modelToUser(u sqlc.User) (*user.User, error) {
 	return domainUser, nil
 }
 
-func modelsToUsers(models []sqlc.User) ([]user.User, error) {
-	op := errs.Op("domain.user.sqlite.models_to_users")
+func modelToQueryUser(u sqlc.User, slotModels []sqlc.UserReminderSlot) (query.User, error) {
+	const op = "domain.user.sqlite.model_to_query_user"
 
-	users := make([]user.User, 0, len(models))
-	for _, model := range models {
-		domainUser, err := modelToUser(model)
-		if err != nil {
-			return nil, errs.WithOp(op, err, "failed to convert model to user")
-		}
-		users = append(users, *domainUser)
+	slots, err := modelsToReminderSlots(slotModels)
+	if err != nil {
+		return query.User{}, errs.WithOp(op, err, "failed to convert reminder slots")
 	}
 
-	return users, nil
-}
-
-func modelToQueryUser(u sqlc.User) (query.User, error) {
-	const op = "domain.user.sqlite.model_to_query_user"
+	quietHours, err := modelToQuietHours(u)
+	if err != nil {
+		return query.User{}, errs.WithOp(op, err, "failed to convert quiet hours")
+	}
 
-	reminderTime, err := modelToReminderTime(u.ReminderTime)
+	reviewIntervals, err := modelToReviewIntervals(u.ReviewIntervals)
 	if err != nil {
-		return query.User{}, errs.WithOp(op, err, "failed to convert reminder time")
+		return query.User{}, errs.WithOp(op, err, "failed to convert review intervals")
 	}
 
-	var language string
-	if u.Language.Valid {
-		language = u.Language.String
-	} else {
-		language = user.DefaultLanguage().String()
+	querySlots := make([]query.ReminderSlot, 0, len(slots))
+	for _, slot := range slots {
+		querySlots = append(querySlots, query.ReminderSlot{
+			ReminderTime: query.ReminderTime{
+				Hour:   slot.Time.Hour,
+				Minute: slot.Time.Minute,
+			},
+			Weekdays: slot.Weekdays.String(),
+		})
 	}
 
 	return query.User{
 		ID:     u.ID,
 		ChatID: u.ChatID,
 		Settings: query.Settings{
-			Language: language,
-			ReminderTime: query.ReminderTime{
-				Hour:   reminderTime.Hour,
-				Minute: reminderTime.Minute,
-			},
+			Language:          modelToLanguage(u.Language).String(),
+			ReminderSlots:     querySlots,
+			QuietHours:        quietHours.String(),
+			ReviewIntervals:   reviewIntervals.String(),
+			DailyReviewLimit:  int(u.DailyReviewLimit),
+			DailyNewItemLimit: int(u.DailyNewItemLimit),
+			Timezone:          u.Timezone,
+			Channels:          strings.Split(u.NotificationChannels, ","),
+			Email:             u.Email.String,
+			WebhookURL:        u.WebhookURL.String,
 		},
 	}, nil
 }
 
+// reminderTimeToModel formats the time as hh:mm, so the slots are matched and ordered as text.
 func reminderTimeToModel(rt user.ReminderTime) string {
-	return fmt.Sprintf("%d:%d", rt.Hour, rt.Minute)
+	return rt.String()
 }
 
 func modelToReminderTime(rt string) (user.ReminderTime, error) {
@@ -312,6 +530,127 @@ func modelToReminderTime(rt string) (user.ReminderTime, error) {
 	return user.ReminderTime{Hour: hour, Minute: minute}, nil
 }
 
+func modelsToReminderSlots(models []sqlc.UserReminderSlot) ([]user.ReminderSlot, error) {
+	const op = "domain.user.sqlite.models_to_reminder_slots"
+
+	slots := make([]user.ReminderSlot, 0, len(models))
+	for _, model := range models {
+		reminderTime, err := modelToReminderTime(model.ReminderTime)
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to convert reminder time")
+		}
+		slots = append(slots, user.ReminderSlot{
+			Time:     reminderTime,
+			Weekdays: user.WeekdayMask(model.Weekdays),
+		})
+	}
+	return slots, nil
+}
+
+// quietHoursToModel stores unset quiet hours as NULL.
+func quietHoursToModel(qh user.QuietHours) (start, end sql.NullString) {
+	if !qh.IsSet() {
+		return sql.NullString{}, sql.NullString{}
+	}
+	return sql.NullString{String: reminderTimeToModel(qh.Start), Valid: true},
+		sql.NullString{String: reminderTimeToModel(qh.End), Valid: true}
+}
+
+func modelToQuietHours(u sqlc.User) (user.QuietHours, error) {
+	const op = "domain.user.sqlite.model_to_quiet_hours"
+
+	if !u.QuietHoursStart.Valid || !u.QuietHoursEnd.Valid {
+		return user.QuietHours{}, nil
+	}
+	start, err := modelToReminderTime(u.QuietHoursStart.String)
+	if err != nil {
+		return user.QuietHours{}, errs.WithOp(op, err, "failed to convert quiet hours start")
+	}
+	end, err := modelToReminderTime(u.QuietHoursEnd.String)
+	if err != nil {
+		return user.QuietHours{}, errs.WithOp(op, err, "failed to convert quiet hours end")
+	}
+	return user.QuietHours{Start: start, End: end}, nil
+}
+
+// channelsToModel joins the channels with commas, there are only a few of them.
+func channelsToModel(channels []user.Channel) string {
+	names := make([]string, len(channels))
+	for i, channel := range channels {
+		names[i] = string(channel)
+	}
+	return strings.Join(names, ",")
+}
+
+func modelToChannels(channels string) ([]user.Channel, error) {
+	const op = "domain.user.sqlite.model_to_channels"
+
+	names := strings.Split(channels, ",")
+	result := make([]user.Channel, 0, len(names))
+	for _, name := range names {
+		channel, err := user.ParseChannel(name)
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to parse channel")
+		}
+		result = append(result, channel)
+	}
+	return result, nil
+}
+
+func stringToNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func modelToLastNotifiedAt(t sql.NullTime) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
+// modelToLanguage returns the stored language, falling back to the default one if it is missing or malformed.
+func modelToLanguage(lang sql.NullString) language.Tag {
+	if !lang.Valid {
+		return user.DefaultLanguage()
+	}
+	tag, err := language.Parse(lang.String)
+	if err != nil || tag == language.Und {
+		return user.DefaultLanguage()
+	}
+	return tag
+}
+
+// reviewIntervalsToModel stores default intervals as NULL,
+// so users who never changed them follow future changes of the defaults.
+func reviewIntervalsToModel(ri valueobject.ReviewInterval) sql.NullString {
+	if ri == valueobject.DefaultReviewIntervals() {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: ri.String(), Valid: true}
+}
+
+func modelToReviewIntervals(ri sql.NullString) (valueobject.ReviewInterval, error) {
+	const op = "domain.user.sqlite.model_to_review_intervals"
+
+	if !ri.Valid {
+		return valueobject.DefaultReviewIntervals(), nil
+	}
+	intervals, err := valueobject.ParseReviewInterval(ri.String)
+	if err != nil {
+		return valueobject.ReviewInterval{}, errs.
+			NewUnknownError(op, err, "failed to parse review intervals").
+			WithContext("review_intervals", ri.String)
+	}
+	return intervals, nil
+}
+
+func modelToDailyQuota(u sqlc.User) valueobject.DailyQuota {
+	return valueobject.DailyQuota{
+		Reviews:  int(u.DailyReviewLimit),
+		NewItems: int(u.DailyNewItemLimit),
+	}
+}
+
 func isValidTimeFormat(s string) bool {
 	_, err := time.Parse("15:04", s)
 	return err == nil
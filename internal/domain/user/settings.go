@@ -2,9 +2,14 @@ package user
 
 import (
 	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"golang.org/x/text/language"
 
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
@@ -12,11 +17,34 @@ var ErrInvalidSettings = errors.New("invalid settings")
 
 // Settings represents user settings.
 type Settings struct {
-	Language     language.Tag
-	ReminderTime ReminderTime
+	Language language.Tag
+	// ReminderSlots are the times of day, in the user's time zone, when reminders are sent.
+	ReminderSlots []ReminderSlot
+	// QuietHours is a window in which no reminders are sent, even if a slot falls into it.
+	QuietHours QuietHours
+	// ReviewIntervals is the ladder of intervals used to schedule the user's revise items.
+	ReviewIntervals valueobject.ReviewInterval
+	// DailyQuota limits the revise items offered per day, the rest is carried over to the next day.
+	DailyQuota valueobject.DailyQuota
+	// Timezone is used to match the reminder time and the day boundaries of the due dates.
+	Timezone valueobject.Timezone
+	// Channels are where the reminders are sent, each of them gets its own notification.
+	Channels []Channel
+	// Email is the address of the email channel, empty if not set.
+	Email string
+	// WebhookURL is where the webhook channel posts the reminders, empty if not set.
+	WebhookURL string
+	// WebhookSecret signs the payloads posted to the WebhookURL, it is set while the webhook channel is on.
+	WebhookSecret string
 }
 
-func NewSettings(lang *language.Tag, reminderTime ReminderTime) (Settings, error) {
+// SettingsOption is a function that applies an option to settings.
+// It is used to configure optional settings during creation.
+type SettingsOption func(*Settings) error
+
+// NewSettings creates settings with a single daily reminder at the given reminder time,
+// use WithReminderSlots to remind more than once a day or only on some weekdays.
+func NewSettings(lang *language.Tag, reminderTime ReminderTime, options ...SettingsOption) (Settings, error) {
 	op := errs.Op("domain.user.new_settings")
 	if lang == nil || *lang == language.Und {
 		return Settings{}, errs.
@@ -28,17 +56,131 @@ func NewSettings(lang *language.Tag, reminderTime ReminderTime) (Settings, error
 		return Settings{}, errs.WithOp(op, err, "reminder time is invalid")
 	}
 
-	return Settings{
-		Language:     *lang,
-		ReminderTime: reminderTime,
-	}, nil
+	settings := Settings{
+		Language:        *lang,
+		ReminderSlots:   []ReminderSlot{{Time: reminderTime, Weekdays: EveryDay}},
+		ReviewIntervals: valueobject.DefaultReviewIntervals(),
+		Timezone:        valueobject.UTC(),
+		Channels:        DefaultChannels(),
+	}
+	for _, option := range options {
+		if err := option(&settings); err != nil {
+			return Settings{}, errs.WithOp(op, err, "failed to apply settings option")
+		}
+	}
+	// the channels and their addresses are set by separate options
+	if err := settings.validateChannelAddresses(); err != nil {
+		return Settings{}, errs.WithOp(op, err, "notification channels are invalid")
+	}
+
+	return settings, nil
+}
+
+func WithReviewIntervals(intervals valueobject.ReviewInterval) SettingsOption {
+	op := errs.Op("domain.user.with_review_intervals")
+	return func(s *Settings) error {
+		if err := intervals.Validate(); err != nil {
+			return errs.WithOp(op, err, "invalid review intervals provided")
+		}
+		s.ReviewIntervals = intervals
+		return nil
+	}
+}
+
+func WithDailyQuota(quota valueobject.DailyQuota) SettingsOption {
+	op := errs.Op("domain.user.with_daily_quota")
+	return func(s *Settings) error {
+		if err := quota.Validate(); err != nil {
+			return errs.WithOp(op, err, "invalid daily quota provided")
+		}
+		s.DailyQuota = quota
+		return nil
+	}
+}
+
+func WithTimezone(tz valueobject.Timezone) SettingsOption {
+	return func(s *Settings) error {
+		s.Timezone = tz
+		return nil
+	}
+}
+
+// WithReminderSlots replaces the reminder slots, no slots means no reminders.
+func WithReminderSlots(slots ...ReminderSlot) SettingsOption {
+	op := errs.Op("domain.user.with_reminder_slots")
+	return func(s *Settings) error {
+		if err := validateReminderSlots(slots); err != nil {
+			return errs.WithOp(op, err, "invalid reminder slots provided")
+		}
+		s.ReminderSlots = slots
+		return nil
+	}
+}
+
+func WithQuietHours(quietHours QuietHours) SettingsOption {
+	op := errs.Op("domain.user.with_quiet_hours")
+	return func(s *Settings) error {
+		if err := quietHours.Validate(); err != nil {
+			return errs.WithOp(op, err, "invalid quiet hours provided")
+		}
+		s.QuietHours = quietHours
+		return nil
+	}
+}
+
+// WithChannels replaces the notification channels, the email and webhook channels need their address set.
+func WithChannels(channels ...Channel) SettingsOption {
+	op := errs.Op("domain.user.with_channels")
+	return func(s *Settings) error {
+		if err := validateChannels(channels); err != nil {
+			return errs.WithOp(op, err, "invalid channels provided")
+		}
+		s.Channels = channels
+		return nil
+	}
+}
+
+func WithEmail(email string) SettingsOption {
+	op := errs.Op("domain.user.with_email")
+	return func(s *Settings) error {
+		if err := validateEmail(email); err != nil {
+			return errs.WithOp(op, err, "invalid email provided")
+		}
+		s.Email = email
+		return nil
+	}
+}
+
+func WithWebhookURL(webhookURL string) SettingsOption {
+	op := errs.Op("domain.user.with_webhook_url")
+	return func(s *Settings) error {
+		if err := validateWebhookURL(webhookURL); err != nil {
+			return errs.WithOp(op, err, "invalid webhook url provided")
+		}
+		s.WebhookURL = webhookURL
+		return nil
+	}
+}
+
+func WithWebhookSecret(secret string) SettingsOption {
+	op := errs.Op("domain.user.with_webhook_secret")
+	return func(s *Settings) error {
+		if err := validateWebhookSecret(secret); err != nil {
+			return errs.WithOp(op, err, "invalid webhook secret provided")
+		}
+		s.WebhookSecret = secret
+		return nil
+	}
 }
 
 // DefaultSettings returns default user settings.
 func DefaultSettings() Settings {
 	return Settings{
-		Language:     DefaultLanguage(),
-		ReminderTime: DefaultReminderTime(),
+		Language:        DefaultLanguage(),
+		ReminderSlots:   []ReminderSlot{DefaultReminderSlot()},
+		ReviewIntervals: valueobject.DefaultReviewIntervals(),
+		Timezone:        valueobject.UTC(),
+		Channels:        DefaultChannels(),
 	}
 }
 
@@ -55,8 +197,131 @@ func (s *Settings) Validate() error {
 			WithMessages([]errs.Message{{Key: "message", Value: "language is not set"}}).
 			WithContext("language", s.Language)
 	}
-	if err := s.ReminderTime.Validate(); err != nil {
-		return errs.WithOp(op, err, "reminder time is invalid")
+	if err := validateReminderSlots(s.ReminderSlots); err != nil {
+		return errs.WithOp(op, err, "reminder slots are invalid")
+	}
+	if err := s.QuietHours.Validate(); err != nil {
+		return errs.WithOp(op, err, "quiet hours are invalid")
+	}
+	if err := s.ReviewIntervals.Validate(); err != nil {
+		return InvalidSettings(op, err, "review intervals are invalid")
+	}
+	if err := s.DailyQuota.Validate(); err != nil {
+		return InvalidSettings(op, err, "daily quota is invalid")
+	}
+	if err := validateChannels(s.Channels); err != nil {
+		return errs.WithOp(op, err, "notification channels are invalid")
+	}
+	if err := validateEmail(s.Email); err != nil {
+		return errs.WithOp(op, err, "email is invalid")
+	}
+	if err := validateWebhookURL(s.WebhookURL); err != nil {
+		return errs.WithOp(op, err, "webhook url is invalid")
+	}
+	if err := validateWebhookSecret(s.WebhookSecret); err != nil {
+		return errs.WithOp(op, err, "webhook secret is invalid")
+	}
+	if err := s.validateChannelAddresses(); err != nil {
+		return errs.WithOp(op, err, "notification channels are invalid")
+	}
+	return nil
+}
+
+// InvalidSettings marks the error of a setting as ErrInvalidSettings, e.g. the one of parsing a value object,
+// the messages of the error are kept.
+func InvalidSettings(op errs.Op, err error, msg string) *errs.Error {
+	if errors.Is(err, ErrInvalidSettings) {
+		return errs.WithOp(op, err, msg)
+	}
+	invalid := errs.NewIncorrectInputError(op, errors.Join(ErrInvalidSettings, err), msg)
+	var appErr *errs.Error
+	if errors.As(err, &appErr) {
+		for key, value := range appErr.Message() {
+			invalid = invalid.WithMessages([]errs.Message{{Key: key, Value: value}})
+		}
+	}
+	return invalid
+}
+
+// HasChannel reports whether the reminders are sent to the channel.
+func (s Settings) HasChannel(channel Channel) bool {
+	return slices.Contains(s.Channels, channel)
+}
+
+// validateChannelAddresses checks that the email and webhook channels have somewhere to send to,
+// and that the webhook url has the secret signing its payloads.
+func (s Settings) validateChannelAddresses() error {
+	op := errs.Op("domain.user.settings.validate_channel_addresses")
+	if s.HasChannel(ChannelEmail) && s.Email == "" {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "email is not set").
+			WithMessages([]errs.Message{{Key: "message", Value: "email must be set to get reminders by email"}})
+	}
+	if s.HasChannel(ChannelWebhook) && s.WebhookURL == "" {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "webhook url is not set").
+			WithMessages([]errs.Message{{Key: "message", Value: "webhook url must be set to get reminders by webhook"}})
+	}
+	if s.HasChannel(ChannelWebhook) && s.WebhookSecret == "" {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "webhook secret is not set").
+			WithMessages([]errs.Message{{Key: "message", Value: "webhook channel must be turned on along with its secret"}})
+	}
+	return nil
+}
+
+// LatestReminder returns the latest reminder slot in (after, until] on the selected weekdays,
+// slots within the quiet hours are skipped.
+func (s Settings) LatestReminder(after, until time.Time) (time.Time, bool) {
+	loc := s.Timezone.Location()
+	until = until.In(loc)
+
+	// the weekdays repeat every week, so there is no need to look back further
+	for i := 0; i <= 7; i++ {
+		day := until.AddDate(0, 0, -i)
+		var latest time.Time
+		for _, slot := range s.ReminderSlots {
+			at := time.Date(day.Year(), day.Month(), day.Day(), int(slot.Time.Hour), int(slot.Time.Minute), 0, 0, loc)
+			switch {
+			case !at.After(after), at.After(until):
+				continue
+			case !slot.Weekdays.Has(at.Weekday()), s.QuietHours.Contains(at):
+				continue
+			}
+			if at.After(latest) {
+				latest = at
+			}
+		}
+		if !latest.IsZero() {
+			return latest, true
+		}
+		if !day.After(after) {
+			break
+		}
+	}
+	return time.Time{}, false
+}
+
+func validateReminderSlots(slots []ReminderSlot) error {
+	op := errs.Op("domain.user.validate_reminder_slots")
+	if len(slots) > MaxReminderSlots {
+		return errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "too many reminder slots").
+			WithMessages([]errs.Message{{Key: "message", Value: fmt.Sprintf("at most %d reminders per day are allowed", MaxReminderSlots)}}).
+			WithContext("count", len(slots))
+	}
+	seen := make(map[ReminderTime]struct{}, len(slots))
+	for _, slot := range slots {
+		if err := slot.Validate(); err != nil {
+			return errs.WithOp(op, err, "reminder slot is invalid")
+		}
+		if _, ok := seen[slot.Time]; ok {
+			return errs.
+				NewIncorrectInputError(op, ErrInvalidSettings, "duplicate reminder slot").
+				WithMessages([]errs.Message{{Key: "message", Value: "reminder times must be unique"}}).
+				WithContext("reminder_time", slot.Time.String())
+		}
+		seen[slot.Time] = struct{}{}
 	}
 	return nil
 }
@@ -98,6 +363,14 @@ func (r *ReminderTime) Validate() error {
 	return nil
 }
 
+func (r ReminderTime) String() string {
+	return fmt.Sprintf("%02d:%02d", r.Hour, r.Minute)
+}
+
+func (r ReminderTime) minuteOfDay() int {
+	return int(r.Hour)*60 + int(r.Minute)
+}
+
 // DefaultReminderTime returns a default reminder time.
 func DefaultReminderTime() ReminderTime {
 	return ReminderTime{
@@ -128,3 +401,16 @@ func NewReminderTime(hour, minute uint8) (ReminderTime, error) {
 		Minute: minute,
 	}, nil
 }
+
+// ParseReminderTime parses a reminder time in the 24-hour "hh:mm" format, e.g. 7:30 or 21:00.
+func ParseReminderTime(s string) (ReminderTime, error) {
+	const op = "domain.user.parse_reminder_time"
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return ReminderTime{}, errs.
+			NewIncorrectInputError(op, ErrInvalidSettings, "invalid reminder time").
+			WithMessages([]errs.Message{{Key: "message", Value: "time must be in the hh:mm format, e.g. 21:00"}}).
+			WithContext("reminder_time", s)
+	}
+	return NewReminderTime(uint8(t.Hour()), uint8(t.Minute()))
+}
@@ -36,6 +36,8 @@ type User struct {
 	createdAt time.Time
 	updatedAt time.Time
 	settings  Settings
+	// lastNotifiedAt is the time of the last reminder slot the user was notified for, zero if never.
+	lastNotifiedAt time.Time
 }
 
 func (u *User) ID() uuid.UUID {
@@ -58,6 +60,29 @@ func (u *User) UpdatedAt() time.Time {
 	return u.updatedAt
 }
 
+func (u *User) LastNotifiedAt() time.Time {
+	return u.lastNotifiedAt
+}
+
+// DueReminder returns the latest reminder slot missed since the user was last notified,
+// looking back at most catchUp, so a reminder missed during a downtime is still sent once.
+// Nothing is due during the quiet hours, a missed reminder is sent after them if it is still within catchUp.
+func (u *User) DueReminder(now time.Time, catchUp time.Duration) (time.Time, bool) {
+	if u.settings.QuietHours.Contains(u.settings.Timezone.In(now)) {
+		return time.Time{}, false
+	}
+
+	after := u.lastNotifiedAt
+	if after.IsZero() {
+		// slots before the registration are not missed
+		after = u.createdAt
+	}
+	if limit := now.Add(-catchUp); after.Before(limit) {
+		after = limit
+	}
+	return u.settings.LatestReminder(after, now)
+}
+
 func (u *User) UpdateSettings(settings Settings) error {
 	op := errs.Op("domain.user.update_settings")
 	if err := settings.Validate(); err != nil {
@@ -138,3 +163,10 @@ func WithUpdatedAt(t time.Time) OptionFunc {
 		return nil
 	}
 }
+
+func WithLastNotifiedAt(t time.Time) OptionFunc {
+	return func(u *User) error {
+		u.lastNotifiedAt = t
+		return nil
+	}
+}
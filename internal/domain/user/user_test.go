@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/text/language"
 
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
 	"github.com/ARUMANDESU/go-revise/pkg/pointers"
 )
 
@@ -111,6 +113,28 @@ func TestUser_UpdateSettings(t *testing.T) {
 			settings:      Settings{},
 			expectedError: ErrInvalidSettings,
 		},
+		{
+			name:          "With custom review intervals",
+			settings:      customIntervalsSettings(t),
+			expectedError: nil,
+		},
+		{
+			name: "With zero review intervals",
+			settings: Settings{
+				Language:      language.Kazakh,
+				ReminderSlots: []ReminderSlot{DefaultReminderSlot()},
+			},
+			expectedError: errs.ErrInvalidInput,
+		},
+		{
+			name: "With negative daily review limit",
+			settings: func() Settings {
+				settings := validSettings(t)
+				settings.DailyQuota.Reviews = -1
+				return settings
+			}(),
+			expectedError: ErrInvalidSettings,
+		},
 	}
 
 	for _, tt := range tests {
@@ -152,8 +176,36 @@ func validSettings(t *testing.T) Settings {
 	return s
 }
 
+func customIntervalsSettings(t *testing.T) Settings {
+	t.Helper()
+
+	intervals, err := valueobject.ParseReviewInterval("1h 1d 1w")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := NewSettings(pointers.New(language.Kazakh), DefaultReminderTime(), WithReviewIntervals(intervals))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
 func invalidSettings(t *testing.T) Settings {
 	t.Helper()
 
 	return Settings{}
 }
+
+func TestInvalidSettings(t *testing.T) {
+	t.Parallel()
+
+	_, parseErr := valueobject.ParseTimezone("Mars/Olympus")
+	require.Error(t, parseErr)
+
+	err := InvalidSettings("test", parseErr, "invalid timezone")
+	require.ErrorIs(t, err, ErrInvalidSettings)
+	require.ErrorIs(t, err, parseErr)
+	require.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+	require.Equal(t, parseErr.(*errs.Error).Message(), err.Message(), "the messages must be kept")
+}
@@ -0,0 +1,44 @@
+package valueobject
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Confidence is the self-rated confidence of the user after a revision, from 1 (not sure) to 5 (certain).
+// The zero value means the confidence was not rated.
+type Confidence uint8
+
+const (
+	ConfidenceNotRated Confidence = 0
+	ConfidenceMin      Confidence = 1
+	ConfidenceMax      Confidence = 5
+)
+
+func (c Confidence) IsValid() bool {
+	return c <= ConfidenceMax
+}
+
+func (c Confidence) IsRated() bool {
+	return c >= ConfidenceMin && c <= ConfidenceMax
+}
+
+// ParseConfidence parses the confidence from its number (1-5), an empty string means not rated.
+func ParseConfidence(s string) (Confidence, error) {
+	op := errs.Op("valueobject.parse_confidence")
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ConfidenceNotRated, nil
+	}
+
+	n, err := strconv.Atoi(s)
+	if err != nil || n < int(ConfidenceMin) || n > int(ConfidenceMax) {
+		return 0, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid confidence").
+			WithMessages([]errs.Message{{Key: "message", Value: "confidence must be between 1 and 5"}}).
+			WithContext("confidence", s)
+	}
+	return Confidence(n), nil
+}
@@ -0,0 +1,80 @@
+package valueobject
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+const maxDailyLimit = 9999
+
+// DailyQuota is the maximum number of revise items offered to the user per day.
+// Reviews limits the items reviewed before, NewItems limits the items never reviewed.
+// Zero means no limit.
+type DailyQuota struct {
+	Reviews  int
+	NewItems int
+}
+
+func (q DailyQuota) Validate() error {
+	op := errs.Op("valueobject.daily_quota.validate")
+	for _, limit := range []int{q.Reviews, q.NewItems} {
+		if limit < 0 || limit > maxDailyLimit {
+			return errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid daily limit").
+				WithMessages([]errs.Message{{Key: "message", Value: "daily limits must be between 0 and 9999, 0 means no limit"}}).
+				WithContext("quota", q)
+		}
+	}
+	return nil
+}
+
+// DailyUsage is the number of revise items the user already went through today.
+type DailyUsage struct {
+	Reviews  int
+	NewItems int
+}
+
+// remaining returns how many more items the limit allows, -1 means no limit.
+func remaining(limit, used int) int {
+	if limit == 0 {
+		return -1
+	}
+	return max(limit-used, 0)
+}
+
+// SelectWithinQuota returns the due items the quota still allows today, the most overdue first,
+// and the number of items carried over to the next day.
+func SelectWithinQuota[T any](
+	items []T,
+	quota DailyQuota,
+	usage DailyUsage,
+	dueAt func(T) time.Time,
+	isNew func(T) bool,
+) ([]T, int) {
+	sorted := slices.Clone(items)
+	slices.SortStableFunc(sorted, func(a, b T) int {
+		return cmp.Compare(dueAt(a).UnixNano(), dueAt(b).UnixNano())
+	})
+
+	reviews := remaining(quota.Reviews, usage.Reviews)
+	newItems := remaining(quota.NewItems, usage.NewItems)
+
+	selected := make([]T, 0, len(sorted))
+	for _, item := range sorted {
+		left := &reviews
+		if isNew(item) {
+			left = &newItems
+		}
+		switch {
+		case *left < 0:
+			selected = append(selected, item)
+		case *left > 0:
+			*left--
+			selected = append(selected, item)
+		}
+	}
+	return selected, len(items) - len(selected)
+}
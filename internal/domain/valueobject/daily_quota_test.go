@@ -0,0 +1,112 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clarify/subtest"
+)
+
+type dueItem struct {
+	name  string
+	dueAt time.Time
+	isNew bool
+}
+
+func TestSelectWithinQuota(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	items := []dueItem{
+		{name: "new today", dueAt: now, isNew: true},
+		{name: "overdue week", dueAt: now.Add(-7 * 24 * time.Hour)},
+		{name: "due today", dueAt: now},
+		{name: "new yesterday", dueAt: now.Add(-24 * time.Hour), isNew: true},
+		{name: "overdue month", dueAt: now.Add(-30 * 24 * time.Hour)},
+	}
+
+	tests := []struct {
+		name            string
+		quota           DailyQuota
+		usage           DailyUsage
+		want            []string
+		wantCarriedOver int
+	}{
+		{
+			name:  "With no limits",
+			quota: DailyQuota{},
+			want:  []string{"overdue month", "overdue week", "new yesterday", "new today", "due today"},
+		},
+		{
+			name:            "With review limit",
+			quota:           DailyQuota{Reviews: 2},
+			want:            []string{"overdue month", "overdue week", "new yesterday", "new today"},
+			wantCarriedOver: 1,
+		},
+		{
+			name:            "With new item limit",
+			quota:           DailyQuota{NewItems: 1},
+			want:            []string{"overdue month", "overdue week", "new yesterday", "due today"},
+			wantCarriedOver: 1,
+		},
+		{
+			name:            "With partially used quota",
+			quota:           DailyQuota{Reviews: 3, NewItems: 2},
+			usage:           DailyUsage{Reviews: 2, NewItems: 1},
+			want:            []string{"overdue month", "new yesterday"},
+			wantCarriedOver: 3,
+		},
+		{
+			name:            "With exhausted quota",
+			quota:           DailyQuota{Reviews: 3, NewItems: 2},
+			usage:           DailyUsage{Reviews: 5, NewItems: 2},
+			want:            []string{},
+			wantCarriedOver: 5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, carriedOver := SelectWithinQuota(
+				items,
+				tt.quota,
+				tt.usage,
+				func(i dueItem) time.Time { return i.dueAt },
+				func(i dueItem) bool { return i.isNew },
+			)
+
+			names := make([]string, 0, len(got))
+			for _, item := range got {
+				names = append(names, item.name)
+			}
+			t.Run("Expect selected items", subtest.Value(names).DeepEqual(tt.want))
+			t.Run("Expect carried over items", subtest.Value(carriedOver).DeepEqual(tt.wantCarriedOver))
+		})
+	}
+}
+
+func TestDailyQuota_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		quota       DailyQuota
+		errExpected bool
+	}{
+		{name: "With no limits", quota: DailyQuota{}},
+		{name: "With limits", quota: DailyQuota{Reviews: 100, NewItems: 20}},
+		{name: "With negative limit", quota: DailyQuota{Reviews: -1}, errExpected: true},
+		{name: "With too big limit", quota: DailyQuota{NewItems: 10000}, errExpected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.quota.Validate()
+			if tt.errExpected {
+				t.Run("Expect error", subtest.Value(err).Error())
+			} else {
+				t.Run("Expect no error", subtest.Value(err).NoError())
+			}
+		})
+	}
+}
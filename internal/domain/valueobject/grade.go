@@ -0,0 +1,67 @@
+package valueobject
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Grade represents how well the user recalled a revise item during a review.
+type Grade uint8
+
+const (
+	GradeAgain Grade = iota + 1 // forgot the item
+	GradeHard                   // recalled with serious difficulty
+	GradeGood                   // recalled after some hesitation
+	GradeEasy                   // recalled perfectly
+)
+
+func (g Grade) IsValid() bool {
+	return g >= GradeAgain && g <= GradeEasy
+}
+
+func (g Grade) String() string {
+	switch g {
+	case GradeAgain:
+		return "again"
+	case GradeHard:
+		return "hard"
+	case GradeGood:
+		return "good"
+	case GradeEasy:
+		return "easy"
+	default:
+		return "unknown"
+	}
+}
+
+func (g Grade) MarshalText() ([]byte, error) {
+	return []byte(g.String()), nil
+}
+
+func (g *Grade) UnmarshalText(text []byte) error {
+	grade, err := ParseGrade(string(text))
+	if err != nil {
+		return err
+	}
+	*g = grade
+	return nil
+}
+
+// ParseGrade parses the grade from its name (again, hard, good, easy) or its number (1-4).
+func ParseGrade(s string) (Grade, error) {
+	op := errs.Op("valueobject.parse_grade")
+	s = strings.ToLower(strings.TrimSpace(s))
+
+	for g := GradeAgain; g <= GradeEasy; g++ {
+		if s == g.String() || s == strconv.Itoa(int(g)) {
+			return g, nil
+		}
+	}
+
+	return 0, errs.
+		NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid grade").
+		WithMessages([]errs.Message{{Key: "message", Value: "grade must be one of: again, hard, good, easy"}}).
+		WithContext("grade", s)
+}
@@ -0,0 +1,40 @@
+package valueobject
+
+import (
+	"strings"
+
+	"golang.org/x/text/language"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// SupportedLanguages are the languages the users can choose, the first one is the fallback.
+var SupportedLanguages = []language.Tag{language.English, language.Russian, language.Kazakh}
+
+var languageMatcher = language.NewMatcher(SupportedLanguages)
+
+// MatchLanguage returns the supported language closest to the given one, English if there is none.
+func MatchLanguage(tag language.Tag) language.Tag {
+	_, index, confidence := languageMatcher.Match(tag)
+	if confidence == language.No {
+		return SupportedLanguages[0]
+	}
+	return SupportedLanguages[index]
+}
+
+// ParseLanguage parses one of the supported languages from its code, e.g. "ru".
+func ParseLanguage(s string) (language.Tag, error) {
+	op := errs.Op("valueobject.parse_language")
+	tag, err := language.Parse(strings.TrimSpace(s))
+	if err == nil {
+		for _, supported := range SupportedLanguages {
+			if tag == supported {
+				return tag, nil
+			}
+		}
+	}
+	return language.Und, errs.
+		NewIncorrectInputError(op, errs.ErrInvalidInput, "unsupported language").
+		WithMessages([]errs.Message{{Key: "message", Value: "language must be one of: en, ru, kk"}}).
+		WithContext("language", s)
+}
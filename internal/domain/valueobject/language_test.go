@@ -0,0 +1,46 @@
+package valueobject
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+func TestMatchLanguage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		tag      language.Tag
+		expected language.Tag
+	}{
+		{name: "English", tag: language.English, expected: language.English},
+		{name: "Regional Russian", tag: language.MustParse("ru-KZ"), expected: language.Russian},
+		{name: "Kazakh", tag: language.Kazakh, expected: language.Kazakh},
+		{name: "Unsupported", tag: language.Japanese, expected: language.English},
+		{name: "Undefined", tag: language.Und, expected: language.English},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, MatchLanguage(tt.tag))
+		})
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	t.Parallel()
+
+	tag, err := ParseLanguage(" kk ")
+	require.NoError(t, err)
+	assert.Equal(t, language.Kazakh, tag)
+
+	_, err = ParseLanguage("ja")
+	assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+	_, err = ParseLanguage("not a language")
+	assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+}
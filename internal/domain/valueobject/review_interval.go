@@ -24,6 +24,50 @@ type ReviewInterval struct {
 	value [maxReviewIntervals]time.Duration
 }
 
+// At returns the interval at the given position of the ladder.
+// Positions past the end of the ladder return the last interval.
+func (r ReviewInterval) At(i int) time.Duration {
+	switch {
+	case i < 0:
+		return r.value[0]
+	case i >= maxReviewIntervals:
+		return r.value[maxReviewIntervals-1]
+	}
+	return r.value[i]
+}
+
+// Len returns the number of intervals in the ladder.
+func (r ReviewInterval) Len() int {
+	return maxReviewIntervals
+}
+
+func (r ReviewInterval) IsZero() bool {
+	return r == ReviewInterval{}
+}
+
+func (r ReviewInterval) Validate() error {
+	op := errs.Op("valueobject.review_interval.validate")
+	for i, v := range r.value {
+		if v <= 0 {
+			return errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "interval must be positive").
+				WithMessages([]errs.Message{{Key: "message", Value: "intervals must be greater than zero"}}).
+				WithContext("index", i).
+				WithContext("interval", v)
+		}
+	}
+	return nil
+}
+
+// String returns the intervals in the format accepted by ParseReviewInterval.
+func (r ReviewInterval) String() string {
+	split := make([]string, 0, maxReviewIntervals)
+	for _, v := range r.value {
+		split = append(split, formatDuration(v))
+	}
+	return strings.Join(split, " ")
+}
+
 func (r ReviewInterval) Next(i int) time.Time {
 	var add time.Duration
 	if i < 0 || i >= maxReviewIntervals {
@@ -77,6 +121,12 @@ func ParseReviewInterval(interval string) (ReviewInterval, error) {
 		if err != nil {
 			return ReviewInterval{}, errs.WithOp(op, err, "failed to parse duration")
 		}
+		if duration <= 0 {
+			return ReviewInterval{}, errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "interval must be positive").
+				WithMessages([]errs.Message{{Key: "message", Value: "intervals must be greater than zero"}}).
+				WithContext("interval", v)
+		}
 		intervals[i] = duration
 	}
 
@@ -90,12 +140,23 @@ func ParseReviewInterval(interval string) (ReviewInterval, error) {
 }
 
 func splitInterval(interval string) []string {
-	return strings.Split(interval, " ")
+	return strings.Fields(interval)
+}
+
+// ParseDuration parses a single duration in the review interval format, e.g. "30m", "1.5d" or "2w".
+func ParseDuration(duration string) (time.Duration, error) {
+	return parseDuration(strings.TrimSpace(duration))
 }
 
 // parseDuration parses the duration from a string with a number followed by a time unit.
 func parseDuration(interval string) (time.Duration, error) {
 	op := errs.Op("valueobject.parse_duration")
+	if len(interval) < 2 {
+		return 0, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid duration").
+			WithMessages([]errs.Message{{Key: "message", Value: "duration must be a number followed by a unit"}}).
+			WithContext("duration", interval)
+	}
 	value := interval[:len(interval)-1]
 	unit := interval[len(interval)-1:]
 
@@ -130,3 +191,26 @@ func parseDuration(interval string) (time.Duration, error) {
 
 	return duration, nil
 }
+
+// units ordered from the largest to the smallest, used to format durations.
+var units = []struct {
+	character string
+	duration  time.Duration
+}{
+	{yearCharacter, time.Hour * 24 * 365},
+	{monthCharacter, time.Hour * 24 * 30},
+	{weekCharacter, time.Hour * 24 * 7},
+	{dayCharacter, time.Hour * 24},
+	{hourCharacter, time.Hour},
+	{minuteCharacter, time.Minute},
+}
+
+// formatDuration formats the duration with the largest unit that divides it without a remainder.
+func formatDuration(d time.Duration) string {
+	for _, u := range units {
+		if d%u.duration == 0 {
+			return strconv.FormatInt(int64(d/u.duration), 10) + u.character
+		}
+	}
+	return strconv.FormatFloat(d.Minutes(), 'f', -1, 64) + minuteCharacter
+}
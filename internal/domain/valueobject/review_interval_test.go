@@ -0,0 +1,105 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clarify/subtest"
+)
+
+func TestParseReviewInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		interval    string
+		want        []time.Duration
+		errExpected bool
+	}{
+		{
+			name:     "With valid intervals",
+			interval: "1d 3d 1w 2M",
+			want:     []time.Duration{24 * time.Hour, 72 * time.Hour, 7 * 24 * time.Hour, 60 * 24 * time.Hour},
+		},
+		{
+			name:     "With extra spaces",
+			interval: " 30m  12h ",
+			want:     []time.Duration{30 * time.Minute, 12 * time.Hour},
+		},
+		{
+			name:     "With empty string",
+			interval: "",
+		},
+		{
+			name:        "With invalid unit",
+			interval:    "1d 3x",
+			errExpected: true,
+		},
+		{
+			name:        "With unit only",
+			interval:    "d",
+			errExpected: true,
+		},
+		{
+			name:        "With zero interval",
+			interval:    "0d",
+			errExpected: true,
+		},
+		{
+			name:        "With negative interval",
+			interval:    "-1d",
+			errExpected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseReviewInterval(tt.interval)
+			if tt.errExpected {
+				t.Run("Expect error", subtest.Value(err).Error())
+				return
+			}
+
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			for i, want := range tt.want {
+				t.Run("Expect parsed interval", subtest.Value(got.At(i)).DeepEqual(want))
+			}
+			for i := len(tt.want); i < maxReviewIntervals; i++ {
+				t.Run("Expect default interval", subtest.Value(got.At(i)).DeepEqual(defaultIntervals[i]))
+			}
+		})
+	}
+}
+
+func TestReviewInterval_String(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		interval string
+	}{
+		{
+			name:     "With default intervals",
+			interval: DefaultReviewIntervals().String(),
+		},
+		{
+			name:     "With custom intervals",
+			interval: "30m 12h 1d 3d 1w 2M 1y",
+		},
+		{
+			name:     "With fractional intervals",
+			interval: "1.5d 0.5m",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := ParseReviewInterval(tt.interval)
+			t.Run("Expect no error", subtest.Value(err).NoError())
+
+			reparsed, err := ParseReviewInterval(parsed.String())
+			t.Run("Expect no error on reparse", subtest.Value(err).NoError())
+			t.Run("Expect same intervals", subtest.Value(reparsed).DeepEqual(parsed))
+		})
+	}
+}
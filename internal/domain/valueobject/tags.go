@@ -1,6 +1,7 @@
 package valueobject
 
 import (
+	"encoding/json"
 	"strings"
 )
 
@@ -38,6 +39,24 @@ func (t *Tags) StringArray() []string {
 	return t.tags
 }
 
+// MarshalJSON marshals the tags as an array of strings, an empty array if there are none.
+func (t Tags) MarshalJSON() ([]byte, error) {
+	if t.tags == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(t.tags)
+}
+
+// UnmarshalJSON unmarshals the tags from an array of strings, they are trimmed and deduplicated as by NewTags.
+func (t *Tags) UnmarshalJSON(data []byte) error {
+	var tags []string
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return err
+	}
+	*t = NewTags(tags...)
+	return nil
+}
+
 func (t *Tags) String() string {
 	if t == nil || len(t.tags) == 0 {
 		return ""
@@ -1,6 +1,7 @@
 package valueobject
 
 import (
+	"encoding/json"
 	"fmt"
 	"testing"
 
@@ -545,3 +546,36 @@ func assertTags(got, want Tags) func(t *testing.T) {
 		}
 	}
 }
+
+func TestTags_JSON(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		tags Tags
+		json string
+	}{
+		{name: "With tags", tags: NewTags("go", "sqlite"), json: `["go","sqlite"]`},
+		{name: "With no tags", tags: Tags{}, json: `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(struct{ Tags Tags }{tt.tags})
+			t.Run("Expect no marshal error", subtest.Value(err).NoError())
+			t.Run("Expect JSON", subtest.Value(string(data)).DeepEqual(`{"Tags":`+tt.json+`}`))
+
+			var got Tags
+			err = json.Unmarshal([]byte(tt.json), &got)
+			t.Run("Expect no unmarshal error", subtest.Value(err).NoError())
+			t.Run(fmt.Sprintf("Expected %v", tt.tags), assertTags(got, tt.tags))
+		})
+	}
+
+	t.Run("Expect unmarshaled tags to be trimmed and unique", func(t *testing.T) {
+		var got Tags
+		err := json.Unmarshal([]byte(`[" go ","go",""]`), &got)
+		t.Run("Expect no error", subtest.Value(err).NoError())
+		t.Run("Expected [go]", assertTags(got, NewTags("go")))
+	})
+}
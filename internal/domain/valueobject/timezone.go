@@ -0,0 +1,81 @@
+package valueobject
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Timezone is an IANA time zone of the user, e.g. Asia/Almaty.
+// The zero value is UTC.
+type Timezone struct {
+	loc *time.Location
+}
+
+// UTC returns the UTC time zone.
+func UTC() Timezone {
+	return Timezone{loc: time.UTC}
+}
+
+// ParseTimezone parses the IANA time zone name, an empty string means UTC.
+func ParseTimezone(name string) (Timezone, error) {
+	op := errs.Op("valueobject.parse_timezone")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return UTC(), nil
+	}
+
+	// time.LoadLocation treats "Local" as the server time zone, which is not what the user means
+	if strings.EqualFold(name, "Local") {
+		return Timezone{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid timezone").
+			WithMessages([]errs.Message{{Key: "message", Value: "timezone must be an IANA time zone name, e.g. Asia/Almaty"}}).
+			WithContext("timezone", name)
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return Timezone{}, errs.
+			NewIncorrectInputError(op, err, "invalid timezone").
+			WithMessages([]errs.Message{{Key: "message", Value: "timezone must be an IANA time zone name, e.g. Asia/Almaty"}}).
+			WithContext("timezone", name)
+	}
+	return Timezone{loc: loc}, nil
+}
+
+// Location returns the location of the time zone.
+func (tz Timezone) Location() *time.Location {
+	if tz.loc == nil {
+		return time.UTC
+	}
+	return tz.loc
+}
+
+func (tz Timezone) String() string {
+	return tz.Location().String()
+}
+
+// In returns the given time in the time zone.
+func (tz Timezone) In(t time.Time) time.Time {
+	return t.In(tz.Location())
+}
+
+// DayBounds returns the start and the end of the local day of the given time in the time zone.
+func (tz Timezone) DayBounds(t time.Time) (start, end time.Time) {
+	t = tz.In(t)
+	start = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 0, 1)
+}
+
+func (tz Timezone) MarshalText() ([]byte, error) {
+	return []byte(tz.String()), nil
+}
+
+func (tz *Timezone) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimezone(string(text))
+	if err != nil {
+		return err
+	}
+	*tz = parsed
+	return nil
+}
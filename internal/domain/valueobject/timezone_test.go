@@ -0,0 +1,88 @@
+package valueobject
+
+import (
+	"testing"
+	"time"
+
+	"github.com/clarify/subtest"
+)
+
+func TestParseTimezone(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       string
+		want        string
+		errExpected bool
+	}{
+		{name: "With empty string", input: "", want: "UTC"},
+		{name: "With UTC", input: "UTC", want: "UTC"},
+		{name: "With IANA name", input: " Asia/Almaty ", want: "Asia/Almaty"},
+		{name: "With unknown name", input: "Mars/Olympus", errExpected: true},
+		{name: "With local", input: "Local", errExpected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tz, err := ParseTimezone(tt.input)
+			if tt.errExpected {
+				t.Run("Expect error", subtest.Value(err).Error())
+				return
+			}
+			t.Run("Expect no error", subtest.Value(err).NoError())
+			t.Run("Expect timezone", subtest.Value(tz.String()).DeepEqual(tt.want))
+		})
+	}
+}
+
+func TestTimezone_DayBounds(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := ParseTimezone("Europe/Berlin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	almaty, err := ParseTimezone("Asia/Almaty")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		tz        Timezone
+		t         time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{
+			name:      "With zero value",
+			tz:        Timezone{},
+			t:         time.Date(2024, 11, 1, 22, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "With timezone ahead of UTC",
+			tz:        almaty,
+			t:         time.Date(2024, 11, 1, 22, 30, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 11, 2, 0, 0, 0, 0, almaty.Location()),
+			wantEnd:   time.Date(2024, 11, 3, 0, 0, 0, 0, almaty.Location()),
+		},
+		{
+			name:      "With daylight saving change",
+			tz:        berlin,
+			t:         time.Date(2024, 10, 27, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 10, 26, 22, 0, 0, 0, time.UTC),
+			wantEnd:   time.Date(2024, 10, 27, 23, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := tt.tz.DayBounds(tt.t)
+			t.Run("Expect day start", subtest.Value(start.Equal(tt.wantStart)).DeepEqual(true))
+			t.Run("Expect day end", subtest.Value(end.Equal(tt.wantEnd)).DeepEqual(true))
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gofrs/uuid"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ChangeReviseItem changes the name, description or interval profile of the revise item.
+// Only the provided fields are changed, an empty interval profile id detaches the profile.
+func (h *Handler) ChangeReviseItem(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.change_revise_item")
+	var input struct {
+		Name              *string `json:"name,omitempty"`
+		Description       *string `json:"description,omitempty"`
+		IntervalProfileID *string `json:"interval_profile_id,omitempty"`
+	}
+
+	if err := httpio.ReadJSON(w, r, &input); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+		return
+	}
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	// the fields are changed by a single command, an invalid one changes nothing
+	cmd := reviseitemcmd.ChangeReviseItem{
+		ID:          id,
+		UserID:      principal.UserID,
+		Name:        input.Name,
+		Description: input.Description,
+	}
+	if input.IntervalProfileID != nil {
+		var profileID uuid.UUID
+		if strings.TrimSpace(*input.IntervalProfileID) != "" {
+			profileID, err = uuid.FromString(strings.TrimSpace(*input.IntervalProfileID))
+			if err != nil {
+				httperr.HandleError(w, r, errs.
+					NewIncorrectInputError(op, err, "invalid interval profile id").
+					WithMessages([]errs.Message{{Key: "message", Value: "invalid interval profile id"}}))
+				return
+			}
+		}
+		cmd.IntervalProfileID = &profileID
+	}
+
+	if err := h.app.ReviseItem.Command.ChangeReviseItem.Handle(r.Context(), cmd); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to change revise item"))
+		return
+	}
+
+	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
+		r.Context(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"revise_item": reviseItem})
+}
@@ -0,0 +1,180 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	userquery "github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/contexts"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// settingsInput is the body of the settings changes, the fields left out are not changed.
+type settingsInput struct {
+	// Language is one of the languages of the bot: en, ru or kk.
+	Language *string `json:"language,omitempty"`
+	// ReminderSlots are like "07:30" or "21:00@workdays".
+	ReminderSlots     *[]string `json:"reminder_slots,omitempty"`
+	QuietHours        *string   `json:"quiet_hours,omitempty"`
+	ReviewIntervals   *string   `json:"review_intervals,omitempty"`
+	DailyReviewLimit  *int      `json:"daily_review_limit,omitempty"`
+	DailyNewItemLimit *int      `json:"daily_new_item_limit,omitempty"`
+	Timezone          *string   `json:"timezone,omitempty"`
+	// Channels are any of "telegram", "email" and "webhook".
+	Channels   *[]string `json:"channels,omitempty"`
+	Email      *string   `json:"email,omitempty"`
+	WebhookURL *string   `json:"webhook_url,omitempty"`
+}
+
+// GetSettings returns the settings of the user who sent the request.
+func (h *Handler) GetSettings(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.get_settings")
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	user, err := h.app.User.Queries.GetUser.Handle(r.Context(), userquery.GetUser{ID: principal.UserID})
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get user"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"settings": user.Settings})
+}
+
+// ChangeSettings changes the settings of the user who sent the request.
+// Only the provided settings are changed, for both PUT and PATCH, so a single one can be changed at a time.
+// Turning the webhook channel on generates its secret, it is returned as webhook_secret once.
+// Invalid settings are answered with 422 Unprocessable Entity.
+func (h *Handler) ChangeSettings(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.change_settings")
+
+	var input settingsInput
+	if err := httpio.ReadJSON(w, r, &input); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	cmd, err := input.command(domainUser.TelegramID(principal.ChatID))
+	if err != nil {
+		handleSettingsError(w, r, errs.WithOp(op, err, "failed to parse settings"))
+		return
+	}
+
+	if err := h.app.User.Commands.ChangeSettings.Handle(r.Context(), cmd); err != nil {
+		handleSettingsError(w, r, errs.WithOp(op, err, "failed to change settings"))
+		return
+	}
+
+	user, err := h.app.User.Queries.GetUser.Handle(r.Context(), userquery.GetUser{ID: principal.UserID})
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get user"))
+		return
+	}
+
+	envelope := httpio.Envelope{"settings": user.Settings}
+	// the secret of the webhook channel is shown only in this response
+	if cmd.WebhookSecret != "" {
+		envelope["webhook_secret"] = cmd.WebhookSecret
+	}
+	httpio.Success(w, r, http.StatusOK, envelope)
+}
+
+// command parses the provided settings into the command changing them.
+func (in settingsInput) command(chatID domainUser.TelegramID) (usercmd.ChangeSettings, error) {
+	op := errs.Op("handler.settings_input.command")
+
+	cmd := usercmd.ChangeSettings{
+		ChatID:            chatID,
+		DailyReviewLimit:  in.DailyReviewLimit,
+		DailyNewItemLimit: in.DailyNewItemLimit,
+		Email:             in.Email,
+		WebhookURL:        in.WebhookURL,
+	}
+	if in.Language != nil {
+		tag, err := valueobject.ParseLanguage(*in.Language)
+		if err != nil {
+			return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid language")
+		}
+		cmd.Language = &tag
+	}
+	if in.ReminderSlots != nil {
+		slots := make([]domainUser.ReminderSlot, 0, len(*in.ReminderSlots))
+		for _, s := range *in.ReminderSlots {
+			slot, err := domainUser.ParseReminderSlot(s)
+			if err != nil {
+				return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid reminder slot")
+			}
+			slots = append(slots, slot)
+		}
+		cmd.ReminderSlots = &slots
+	}
+	if in.QuietHours != nil {
+		quietHours, err := domainUser.ParseQuietHours(*in.QuietHours)
+		if err != nil {
+			return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid quiet hours")
+		}
+		cmd.QuietHours = &quietHours
+	}
+	if in.ReviewIntervals != nil {
+		intervals, err := valueobject.ParseReviewInterval(*in.ReviewIntervals)
+		if err != nil {
+			return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid review intervals")
+		}
+		cmd.ReviewIntervals = &intervals
+	}
+	if in.Timezone != nil {
+		tz, err := valueobject.ParseTimezone(*in.Timezone)
+		if err != nil {
+			return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid timezone")
+		}
+		cmd.Timezone = &tz
+	}
+	if in.Channels != nil {
+		channels := make([]domainUser.Channel, 0, len(*in.Channels))
+		for _, s := range *in.Channels {
+			channel, err := domainUser.ParseChannel(s)
+			if err != nil {
+				return usercmd.ChangeSettings{}, domainUser.InvalidSettings(op, err, "invalid channel")
+			}
+			channels = append(channels, channel)
+		}
+		cmd.Channels = &channels
+
+		if slices.Contains(channels, domainUser.ChannelWebhook) {
+			secret, err := domainUser.NewWebhookSecret()
+			if err != nil {
+				return usercmd.ChangeSettings{}, errs.WithOp(op, err, "failed to generate webhook secret")
+			}
+			cmd.WebhookSecret = secret
+		}
+	}
+	return cmd, nil
+}
+
+// handleSettingsError answers the invalid settings with 422 Unprocessable Entity,
+// the other errors are handled as usual.
+func handleSettingsError(w http.ResponseWriter, r *http.Request, err error) {
+	var appErr *errs.Error
+	if !errors.Is(err, domainUser.ErrInvalidSettings) || !errors.As(err, &appErr) {
+		httperr.HandleError(w, r, err)
+		return
+	}
+	appErr.Log(contexts.Logger(r.Context()))
+	httperr.UnprocessableEntity(w, r, appErr.Message()["message"])
+}
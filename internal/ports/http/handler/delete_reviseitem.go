@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"net/http"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// DeleteReviseItem deletes the revise item, it can be restored with RestoreReviseItem.
+func (h *Handler) DeleteReviseItem(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.delete_revise_item")
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	err = h.app.ReviseItem.Command.DeleteReviseItem.Handle(
+		r.Context(),
+		reviseitemcmd.DeleteReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to delete revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, nil)
+}
+
+// RestoreReviseItem restores the deleted revise item.
+func (h *Handler) RestoreReviseItem(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.restore_revise_item")
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	err = h.app.ReviseItem.Command.RestoreReviseItem.Handle(
+		r.Context(),
+		reviseitemcmd.RestoreReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to restore revise item"))
+		return
+	}
+
+	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
+		r.Context(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"revise_item": reviseItem})
+}
@@ -3,32 +3,32 @@ package handler
 import (
 	"net/http"
 
-	"github.com/gofrs/uuid"
-
 	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
 	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
 	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
+// GetReviseItem returns the revise item of the user with its revisions.
 func (h *Handler) GetReviseItem(w http.ResponseWriter, r *http.Request) {
 	op := errs.Op("handler.get_revise_item")
 
-	var input struct {
-		ID     uuid.UUID `json:"id"`
-		UserID uuid.UUID `json:"user_id"`
-	}
-	if err := httpio.ReadJSON(w, r, &input); err != nil {
-		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
 		return
 	}
 
-	query := reviseitemquery.GetReviseItem{
-		ID:     input.ID,
-		UserID: input.UserID,
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
 	}
 
-	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(r.Context(), query)
+	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
+		r.Context(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: principal.UserID},
+	)
 	if err != nil {
 		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
 		return
@@ -9,16 +9,17 @@ import (
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
+// GetUser returns the user who sent the request.
 func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	op := errs.Op("handler.get_user")
 
-	var query userquery.GetUser
-	if err := httpio.ReadJSON(w, r, &query); err != nil {
-		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
 		return
 	}
 
-	user, err := h.app.User.Queries.GetUser.Handle(r.Context(), query)
+	user, err := h.app.User.Queries.GetUser.Handle(r.Context(), userquery.GetUser{ID: principal.UserID})
 	if err != nil {
 		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get user"))
 		return
@@ -1,7 +1,16 @@
 package handler
 
 import (
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+
 	"github.com/ARUMANDESU/go-revise/internal/application"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/pkg/contexts"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
 type Handler struct {
@@ -11,3 +20,49 @@ type Handler struct {
 func NewHandler(app application.Application) *Handler {
 	return &Handler{app: app}
 }
+
+// authPrincipal returns the user who sent the request, put into the context by the auth middleware.
+func authPrincipal(r *http.Request) (contexts.Principal, error) {
+	op := errs.Op("handler.auth_principal")
+	principal, ok := contexts.PrincipalFrom(r.Context())
+	if !ok {
+		return contexts.Principal{}, errs.
+			NewAuthorizationError(op, nil, "principal is missing").
+			WithMessages([]errs.Message{{Key: "message", Value: "authorization is required"}})
+	}
+	return principal, nil
+}
+
+// urlID returns the id from the "id" url parameter.
+func urlID(r *http.Request) (uuid.UUID, error) {
+	op := errs.Op("handler.url_id")
+	id, err := uuid.FromString(chi.URLParam(r, "id"))
+	if err != nil {
+		return uuid.Nil, errs.
+			NewIncorrectInputError(op, err, "invalid id").
+			WithMessages([]errs.Message{{Key: "message", Value: "invalid id"}})
+	}
+	return id, nil
+}
+
+// urlPagination returns the pagination from the "page" and "page_size" query parameters,
+// the missing ones are left zero to get the defaults.
+func urlPagination(r *http.Request) (reviseitemquery.Pagination, error) {
+	op := errs.Op("handler.url_pagination")
+	var pagination reviseitemquery.Pagination
+	for param, value := range map[string]*int{"page": &pagination.Page, "page_size": &pagination.PageSize} {
+		s := r.URL.Query().Get(param)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 1 {
+			return reviseitemquery.Pagination{}, errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid pagination").
+				WithMessages([]errs.Message{{Key: "message", Value: param + " must be a positive number"}}).
+				WithContext(param, s)
+		}
+		*value = n
+	}
+	return pagination, nil
+}
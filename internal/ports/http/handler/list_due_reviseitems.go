@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ListDueReviseItems lists the revise items the user should revise today within the daily quota.
+func (h *Handler) ListDueReviseItems(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.list_due_revise_items")
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	due, err := h.app.ReviseItem.Query.ListDueReviseItems.Handle(
+		r.Context(),
+		reviseitemquery.ListDueReviseItems{UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to list due revise items"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{
+		"revise_items": due.Items,
+		"carried_over": due.CarriedOver,
+	})
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"net/http"
+
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ListReviseItems lists the revise items of the user, the newest first.
+// The page is taken from the "page" and "page_size" query parameters.
+func (h *Handler) ListReviseItems(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.list_revise_items")
+
+	pagination, err := urlPagination(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get pagination"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	items, metadata, err := h.app.ReviseItem.Query.ListUserReviseItems.Handle(
+		r.Context(),
+		reviseitemquery.ListUserReviseItems{UserID: principal.UserID, Pagination: pagination},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to list revise items"))
+		return
+	}
+	if items == nil {
+		items = []reviseitemquery.ReviseItem{}
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"revise_items": items, "metadata": metadata})
+}
@@ -13,13 +13,17 @@ import (
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
+// NewReviseItem creates a revise item of the user.
 func (h *Handler) NewReviseItem(w http.ResponseWriter, r *http.Request) {
 	op := errs.Op("handler.new_revise_item")
 	var input struct {
-		UserID      uuid.UUID `json:"user_id"`
-		Name        string    `json:"name"`
-		Description *string   `json:"description,omitempty"`
-		Tags        []string  `json:"tags,omitempty"`
+		Name        string   `json:"name"`
+		Description *string  `json:"description,omitempty"`
+		Tags        []string `json:"tags,omitempty"`
+		// Source is the URL the item is about, the name defaults to the title of the page.
+		Source string `json:"source,omitempty"`
+		// IntervalProfileID is the interval profile to schedule the item with, optional.
+		IntervalProfileID uuid.UUID `json:"interval_profile_id,omitempty"`
 	}
 
 	if err := httpio.ReadJSON(w, r, &input); err != nil {
@@ -27,26 +31,34 @@ func (h *Handler) NewReviseItem(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
 	reviseItemID := uuid.Must(uuid.NewV4())
 	cmd := reviseitemcmd.NewReviseItem{
 		ID:     reviseItemID,
-		UserID: input.UserID,
+		UserID: principal.UserID,
 		Name:   input.Name,
 		Tags:   valueobject.NewTags(input.Tags...),
+		Source: input.Source,
+
+		IntervalProfileID: input.IntervalProfileID,
 	}
 	if input.Description != nil {
 		cmd.Description = *input.Description
 	}
 
-	err := h.app.ReviseItem.Command.NewReviseItem.Handle(r.Context(), cmd)
-	if err != nil {
+	if err := h.app.ReviseItem.Command.NewReviseItem.Handle(r.Context(), cmd); err != nil {
 		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to create new revise item"))
 		return
 	}
 
 	queryReviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
 		r.Context(),
-		reviseitemquery.GetReviseItem{ID: reviseItemID, UserID: input.UserID},
+		reviseitemquery.GetReviseItem{ID: reviseItemID, UserID: principal.UserID},
 	)
 	if err != nil {
 		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
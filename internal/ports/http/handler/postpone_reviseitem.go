@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// PostponeReviseItem moves the next revision of the revise item without counting as a revision.
+// The item is postponed either by a duration (e.g. "1h", "2d") or until the given time.
+func (h *Handler) PostponeReviseItem(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.postpone_revise_item")
+	var input struct {
+		Duration string    `json:"duration,omitempty"`
+		Until    time.Time `json:"until,omitempty"`
+	}
+
+	if err := httpio.ReadJSON(w, r, &input); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+		return
+	}
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	cmd := reviseitemcmd.Postpone{ID: id, UserID: principal.UserID, Until: input.Until}
+	if input.Duration != "" {
+		cmd.Duration, err = valueobject.ParseDuration(input.Duration)
+		if err != nil {
+			httperr.HandleError(w, r, errs.WithOp(op, err, "failed to parse duration"))
+			return
+		}
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Postpone.Handle(r.Context(), cmd)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to postpone revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"next_revision_at": nextRevisionAt})
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ResetReviseItemProgress restarts the progress of the revise item, the revision history is kept.
+func (h *Handler) ResetReviseItemProgress(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.reset_revise_item_progress")
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.ResetProgress.Handle(
+		r.Context(),
+		reviseitemcmd.ResetProgress{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to reset revise item progress"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"next_revision_at": nextRevisionAt})
+}
@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ReviewReviseItem marks the revise item reviewed with the grade and schedules its next revision.
+// A relapse moves a forgotten item back by the given number of steps instead.
+func (h *Handler) ReviewReviseItem(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.review_revise_item")
+	var input struct {
+		// Grade is one of "again", "hard", "good" and "easy", or their numbers 1-4.
+		Grade   string `json:"grade,omitempty"`
+		Relapse int    `json:"relapse,omitempty"`
+		Notes   string `json:"notes,omitempty"`
+		// DurationSeconds is how long the revision took.
+		DurationSeconds int `json:"duration_seconds,omitempty"`
+		// Confidence is from 1 (not sure) to 5 (certain), zero if not rated.
+		Confidence int `json:"confidence,omitempty"`
+	}
+
+	if err := httpio.ReadJSON(w, r, &input); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+		return
+	}
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	cmd := reviseitemcmd.Review{
+		ID:       id,
+		UserID:   principal.UserID,
+		Relapse:  input.Relapse,
+		Notes:    input.Notes,
+		Duration: time.Duration(input.DurationSeconds) * time.Second,
+	}
+	if input.Grade != "" {
+		cmd.Grade, err = valueobject.ParseGrade(input.Grade)
+		if err != nil {
+			httperr.HandleError(w, r, errs.WithOp(op, err, "failed to parse grade"))
+			return
+		}
+	}
+	if input.Confidence != 0 {
+		cmd.Confidence, err = valueobject.ParseConfidence(strconv.Itoa(input.Confidence))
+		if err != nil {
+			httperr.HandleError(w, r, errs.WithOp(op, err, "failed to parse confidence"))
+			return
+		}
+	}
+	if input.DurationSeconds < 0 {
+		httperr.HandleError(w, r, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "negative duration").
+			WithMessages([]errs.Message{{Key: "message", Value: "duration_seconds must not be negative"}}))
+		return
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Review.Handle(r.Context(), cmd)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to review revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"next_revision_at": nextRevisionAt})
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/httpio"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// AddReviseItemTags adds the tags to the revise item.
+func (h *Handler) AddReviseItemTags(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.add_revise_item_tags")
+	var input struct {
+		Tags []string `json:"tags"`
+	}
+
+	if err := httpio.ReadJSON(w, r, &input); err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to read JSON"))
+		return
+	}
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	err = h.app.ReviseItem.Command.AddTags.Handle(
+		r.Context(),
+		reviseitemcmd.AddTags{ID: id, UserID: principal.UserID, Tags: valueobject.NewTags(input.Tags...)},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to add tags"))
+		return
+	}
+
+	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
+		r.Context(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"revise_item": reviseItem})
+}
+
+// RemoveReviseItemTag removes the tag of the "tag" url parameter from the revise item.
+func (h *Handler) RemoveReviseItemTag(w http.ResponseWriter, r *http.Request) {
+	op := errs.Op("handler.remove_revise_item_tag")
+
+	id, err := urlID(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item id"))
+		return
+	}
+
+	principal, err := authPrincipal(r)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get principal"))
+		return
+	}
+
+	err = h.app.ReviseItem.Command.RemoveTags.Handle(
+		r.Context(),
+		reviseitemcmd.RemoveTags{ID: id, UserID: principal.UserID, Tags: valueobject.NewTags(chi.URLParam(r, "tag"))},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to remove tag"))
+		return
+	}
+
+	reviseItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
+		r.Context(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: principal.UserID},
+	)
+	if err != nil {
+		httperr.HandleError(w, r, errs.WithOp(op, err, "failed to get revise item"))
+		return
+	}
+
+	httpio.Success(w, r, http.StatusOK, httpio.Envelope{"revise_item": reviseItem})
+}
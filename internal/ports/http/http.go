@@ -21,11 +21,18 @@ type Port struct {
 }
 
 func NewPort(cfg config.Config, app application.Application) *Port {
-	return &Port{
+	p := &Port{
 		handler:    handler.NewHandler(app),
 		mux:        chi.NewRouter(),
-		middleware: middlewares.NewMiddleware(cfg.EnvMode, cfg.Telegram.Token),
+		middleware: middlewares.NewMiddleware(cfg, app),
 	}
+	p.setUpRouter()
+	return p
+}
+
+// Handler returns the router of the port, e.g. to serve it with httptest.
+func (p *Port) Handler() http.Handler {
+	return p.mux
 }
 
 // Start starts the http server.
@@ -33,7 +40,6 @@ func NewPort(cfg config.Config, app application.Application) *Port {
 //	NOTE: This function will block the current goroutine.
 func (p *Port) Start(port string) error {
 	op := errs.Op("http.Port.Start")
-	p.setUpRouter()
 	p.server = &http.Server{
 		Addr:    ":" + port,
 		Handler: p.mux,
@@ -68,6 +68,10 @@ func BadRequest(w http.ResponseWriter, r *http.Request, message string) {
 	Error(w, r, http.StatusBadRequest, "bad-request", message)
 }
 
+func UnprocessableEntity(w http.ResponseWriter, r *http.Request, message string) {
+	Error(w, r, http.StatusUnprocessableEntity, "unprocessable-entity", message)
+}
+
 func NotFound(w http.ResponseWriter, r *http.Request, message string) {
 	Error(w, r, http.StatusNotFound, "not-found", message)
 }
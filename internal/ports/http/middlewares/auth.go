@@ -1,78 +1,205 @@
 package middlewares
 
 import (
+	"context"
 	"errors"
-	"log/slog"
 	"net/http"
 	"strings"
 	"time"
 
+	"github.com/gofrs/uuid"
 	initdata "github.com/telegram-mini-apps/init-data-golang"
+	"golang.org/x/text/language"
 
+	apitokencmd "github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	userquery "github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/internal/ports/http/httperr"
 	"github.com/ARUMANDESU/go-revise/pkg/contexts"
 	"github.com/ARUMANDESU/go-revise/pkg/env"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
+// Auth authorizes the requests with the "tma <init data>" of the Telegram Mini App
+// or the "Bearer <api token>" of the personal access tokens, and puts the principal of the user
+// into the context. The invalid credentials are answered with 401 Unauthorized, the telegram users
+// who are not registered and the read only tokens changing data with 403 Forbidden.
 func (m *Middleware) Auth(next http.Handler) http.Handler {
 	op := errs.Op("middleware.auth")
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		header := strings.TrimSpace(r.Header.Get("authorization"))
 		if header == "" {
-			slog.Info("header is empty")
+			err := errs.
+				NewAuthorizationError(op, nil, "authorization header is missing").
+				WithMessages([]errs.Message{{Key: "message", Value: "authorization header is required"}})
+			httperr.HandleError(w, r, err)
 			return
 		}
 		authParts := strings.Split(header, " ")
 		if len(authParts) != 2 {
 			err := errs.
-				NewIncorrectInputError(op, nil, "invalid authorization header format").
-				WithMessages([]errs.Message{{Key: "message", Value: "invalid authorization header format, should be '<auth_type> <auth_data>'"}}).
-				WithContext("header", header)
+				NewAuthorizationError(op, nil, "invalid authorization header format").
+				WithMessages([]errs.Message{{Key: "message", Value: "invalid authorization header format, should be '<auth_type> <auth_data>'"}})
 			httperr.HandleError(w, r, err)
 			return
 		}
 		authType := authParts[0]
 		authData := authParts[1]
 
-		switch authType {
+		var principal contexts.Principal
+		var err error
+		switch strings.ToLower(authType) {
 		case "tma":
-			expIn := time.Hour
-			if m.EnvMode != env.Local {
-				if err := initdata.Validate(authData, m.tmaAuthToken, expIn); err != nil {
-					handleInitDataError(w, r, err, "failed to validate tma authorization", authData)
-					return
-				}
-			}
-
-			initData, err := initdata.Parse(authData)
-			if err != nil {
-				handleInitDataError(w, r, err, "failed to parse tma authorization", authData)
-				return
-			}
-
-			r = r.WithContext(contexts.WithTMAInitData(r.Context(), initData))
+			principal, err = m.tmaPrincipal(r.Context(), authData)
+		case "bearer":
+			principal, err = m.tokenPrincipal(r.Context(), authData)
 		default:
-			err := errs.
-				NewIncorrectInputError(op, nil, "unsupported authorization type").
+			err = errs.
+				NewAuthorizationError(op, nil, "unsupported authorization type").
 				WithMessages([]errs.Message{{Key: "message", Value: "unsupported authorization type"}}).
-				WithContext("auth_type", authType).
-				WithContext("auth_data", authData)
+				WithContext("auth_type", authType)
+		}
+		if err != nil {
+			httperr.HandleError(w, r, errs.WithOp(op, err, "failed to authorize request"))
+			return
+		}
+
+		// the read only tokens can't change anything
+		if !principal.HasScope(apitoken.ScopeWrite.String()) && !isSafeMethod(r.Method) {
+			err := errs.
+				NewForbiddenError(op, nil, "api token is read only").
+				WithMessages([]errs.Message{{Key: "message", Value: "the api token is read only"}}).
+				WithContext("token_id", principal.TokenID).
+				WithContext("method", r.Method)
 			httperr.HandleError(w, r, err)
 			return
 		}
-		next.ServeHTTP(w, r)
+
+		next.ServeHTTP(w, r.WithContext(contexts.WithPrincipal(r.Context(), principal)))
 	})
 }
 
-func handleInitDataError(
-	w http.ResponseWriter,
-	r *http.Request,
-	err error,
-	msg string,
-	authData string,
-) {
-	op := errs.Op("middleware.auth.handle_init_data_error")
+// tmaPrincipal returns the principal of the registered user of the init data,
+// the unknown users are registered first if the auto registration is on.
+func (m *Middleware) tmaPrincipal(ctx context.Context, authData string) (contexts.Principal, error) {
+	op := errs.Op("middleware.auth.tma_principal")
+
+	if m.EnvMode != env.Local {
+		if err := initdata.Validate(authData, m.tmaAuthToken, time.Hour); err != nil {
+			return contexts.Principal{}, initDataError(err, "failed to validate tma authorization", authData)
+		}
+	}
+	initData, err := initdata.Parse(authData)
+	if err != nil {
+		return contexts.Principal{}, initDataError(err, "failed to parse tma authorization", authData)
+	}
+	if initData.User.ID == 0 {
+		return contexts.Principal{}, errs.
+			NewAuthorizationError(op, nil, "tma init data has no user").
+			WithMessages([]errs.Message{{Key: "message", Value: "tma auth data has no user"}})
+	}
+	chatID := user.TelegramID(initData.User.ID)
+
+	u, err := m.users.Handle(ctx, userquery.GetUser{ChatID: chatID})
+	if errs.IsErrorType(err, errs.ErrorTypeNotFound) && m.autoRegister {
+		u, err = m.register(ctx, chatID, initData.User.LanguageCode)
+	}
+	if errs.IsErrorType(err, errs.ErrorTypeNotFound) {
+		return contexts.Principal{}, errs.
+			NewForbiddenError(op, err, "user is not registered").
+			WithMessages([]errs.Message{{Key: "message", Value: "user is not registered, register in the bot first"}}).
+			WithContext("chat_id", chatID)
+	}
+	if err != nil {
+		return contexts.Principal{}, errs.WithOp(op, err, "failed to get user")
+	}
+
+	return principalOf(u, nil, apitoken.ScopeRead, apitoken.ScopeWrite)
+}
+
+// register registers the telegram user with the language of the telegram client, like the bot does.
+func (m *Middleware) register(ctx context.Context, chatID user.TelegramID, languageCode string) (userquery.User, error) {
+	op := errs.Op("middleware.auth.register")
+
+	tag, err := language.Parse(languageCode)
+	if err != nil {
+		tag = language.Und
+	}
+	settings := user.DefaultSettings()
+	settings.Language = valueobject.MatchLanguage(tag)
+
+	err = m.registerer.Handle(ctx, usercmd.RegisterUser{ChatID: chatID, Settings: &settings})
+	// a concurrent request registered the user first
+	if err != nil && !errs.IsErrorType(err, errs.ErrorTypeAlreadyExists) {
+		return userquery.User{}, errs.WithOp(op, err, "failed to register user")
+	}
+
+	u, err := m.users.Handle(ctx, userquery.GetUser{ChatID: chatID})
+	if err != nil {
+		return userquery.User{}, errs.WithOp(op, err, "failed to get registered user")
+	}
+	return u, nil
+}
+
+// tokenPrincipal returns the principal of the user of the api token, scoped to what the token allows.
+func (m *Middleware) tokenPrincipal(ctx context.Context, secret string) (contexts.Principal, error) {
+	op := errs.Op("middleware.auth.token_principal")
+
+	token, err := m.tokens.Handle(ctx, apitokencmd.Authenticate{Token: secret})
+	if err != nil {
+		return contexts.Principal{}, errs.WithOp(op, err, "failed to authenticate api token")
+	}
+
+	u, err := m.users.Handle(ctx, userquery.GetUser{ID: token.UserID})
+	if errs.IsErrorType(err, errs.ErrorTypeNotFound) {
+		return contexts.Principal{}, errs.
+			NewAuthorizationError(op, err, "user of the api token is not found").
+			WithMessages([]errs.Message{{Key: "message", Value: "invalid or revoked api token"}}).
+			WithContext("token_id", token.TokenID)
+	}
+	if err != nil {
+		return contexts.Principal{}, errs.WithOp(op, err, "failed to get user")
+	}
+
+	scopes := []apitoken.Scope{apitoken.ScopeRead}
+	if token.Scope.Includes(apitoken.ScopeWrite) {
+		scopes = append(scopes, apitoken.ScopeWrite)
+	}
+	return principalOf(u, &token.TokenID, scopes...)
+}
+
+func principalOf(u userquery.User, tokenID *uuid.UUID, scopes ...apitoken.Scope) (contexts.Principal, error) {
+	op := errs.Op("middleware.auth.principal_of")
+
+	userID, err := uuid.FromString(u.ID)
+	if err != nil {
+		return contexts.Principal{}, errs.NewUnknownError(op, err, "failed to parse user id").WithContext("id", u.ID)
+	}
+	principal := contexts.Principal{
+		UserID:   userID,
+		ChatID:   u.ChatID,
+		Language: u.Settings.Language,
+		Scopes:   make([]string, 0, len(scopes)),
+	}
+	if tokenID != nil {
+		principal.TokenID = *tokenID
+	}
+	for _, scope := range scopes {
+		principal.Scopes = append(principal.Scopes, scope.String())
+	}
+	return principal, nil
+}
+
+// isSafeMethod reports whether the method only reads.
+func isSafeMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
+
+func initDataError(err error, msg string, authData string) *errs.Error {
+	op := errs.Op("middleware.auth.init_data_error")
 	var appErr *errs.Error
 	switch {
 	case errors.Is(err, initdata.ErrUnexpectedFormat):
@@ -98,7 +225,5 @@ func handleInitDataError(
 	default:
 		appErr = errs.NewUnknownError(op, err, msg)
 	}
-	err = appErr.WithContext("auth_data", authData)
-
-	httperr.HandleError(w, r, err)
+	return appErr.WithContext("auth_data", authData)
 }
@@ -1,16 +1,49 @@
 package middlewares
 
-import "github.com/ARUMANDESU/go-revise/pkg/env"
+import (
+	"context"
+
+	"github.com/ARUMANDESU/go-revise/internal/application"
+	apitokencmd "github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	userquery "github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/pkg/env"
+)
+
+// UserProvider looks up the users the requests are authorized as.
+type UserProvider interface {
+	Handle(ctx context.Context, query userquery.GetUser) (userquery.User, error)
+}
+
+// UserRegisterer registers the unknown telegram users if the auto registration is on.
+type UserRegisterer interface {
+	Handle(ctx context.Context, cmd usercmd.RegisterUser) error
+}
+
+// TokenAuthenticator looks up the user of the api tokens sent as Bearer tokens.
+type TokenAuthenticator interface {
+	Handle(ctx context.Context, cmd apitokencmd.Authenticate) (apitokencmd.Authenticated, error)
+}
 
 type Middleware struct {
 	EnvMode env.Mode
 	// telegram bot secret token
 	tmaAuthToken string
+	// autoRegister registers the unknown telegram users on their first request instead of rejecting them.
+	autoRegister bool
+	users        UserProvider
+	registerer   UserRegisterer
+	tokens       TokenAuthenticator
 }
 
-func NewMiddleware(envMode env.Mode, botToken string) Middleware {
+func NewMiddleware(cfg config.Config, app application.Application) Middleware {
 	return Middleware{
-		EnvMode:      envMode,
-		tmaAuthToken: botToken,
+		EnvMode:      cfg.EnvMode,
+		tmaAuthToken: cfg.Telegram.Token,
+		autoRegister: cfg.HTTP.AutoRegister,
+		users:        app.User.Queries.GetUser,
+		registerer:   app.User.Commands.RegisterUser,
+		tokens:       &app.APIToken.Commands.Authenticate,
 	}
 }
@@ -0,0 +1,22 @@
+// Package openapi holds the OpenAPI 3 document describing the /api/v1 routes of the http port.
+//
+// The document is written by hand, the contract test of the http port keeps it in sync with the router
+// and with the bodies the handlers actually send.
+package openapi
+
+import (
+	_ "embed"
+	"net/http"
+)
+
+// Document is the OpenAPI document as JSON.
+//
+//go:embed openapi.json
+var Document []byte
+
+// Serve writes the OpenAPI document.
+func Serve(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(Document)
+}
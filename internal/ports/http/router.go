@@ -5,6 +5,8 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
+
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/openapi"
 )
 
 func (p *Port) setUpRouter() {
@@ -23,17 +25,34 @@ func (p *Port) setUpRouter() {
 	})
 
 	r.Route("/api/v1", func(v1 chi.Router) {
+		v1.Get("/openapi.json", openapi.Serve)
+
 		v1.Route("/users", func(r chi.Router) {
 			r.Post("/register", p.handler.RegisterUser)
 
 			r.With(p.middleware.Auth).Get("/", p.handler.GetUser)
+			r.With(p.middleware.Auth).Get("/settings", p.handler.GetSettings)
+			r.With(p.middleware.Auth).Put("/settings", p.handler.ChangeSettings)
+			r.With(p.middleware.Auth).Patch("/settings", p.handler.ChangeSettings)
 		})
 
 		v1.Route("/revise-items", func(r chi.Router) {
 			r.Use(p.middleware.Auth)
+			r.Get("/", p.handler.ListReviseItems)
 			r.Post("/", p.handler.NewReviseItem)
-
-			r.Get("/", p.handler.GetReviseItem)
+			r.Get("/due", p.handler.ListDueReviseItems)
+
+			r.Route("/{id}", func(r chi.Router) {
+				r.Get("/", p.handler.GetReviseItem)
+				r.Patch("/", p.handler.ChangeReviseItem)
+				r.Delete("/", p.handler.DeleteReviseItem)
+				r.Post("/restore", p.handler.RestoreReviseItem)
+				r.Post("/tags", p.handler.AddReviseItemTags)
+				r.Delete("/tags/{tag}", p.handler.RemoveReviseItemTag)
+				r.Post("/review", p.handler.ReviewReviseItem)
+				r.Post("/postpone", p.handler.PostponeReviseItem)
+				r.Post("/reset-progress", p.handler.ResetReviseItemProgress)
+			})
 		})
 	})
 }
@@ -1,7 +1,113 @@
 package button
 
 import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
 	tb "gopkg.in/telebot.v4"
 )
 
 var RegistrationConfirmI = tb.InlineButton{Unique: "confirm", Text: "✅ Confirm"}
+
+// PostponeI is the endpoint of the postpone buttons, the data is "<item id>|<duration>".
+var PostponeI = tb.InlineButton{Unique: "postpone"}
+
+// Postpone returns a button postponing the revise item by the given duration.
+func Postpone(itemID uuid.UUID, duration time.Duration, text string) tb.InlineButton {
+	btn := PostponeI
+	btn.Text = text
+	btn.Data = itemID.String() + "|" + duration.String()
+	return btn
+}
+
+// Digest endpoints, the data of the item buttons is "<item id>|<page>" and of the page buttons is "<page>".
+var (
+	DigestReviewedI = tb.InlineButton{Unique: "digest_reviewed"}
+	DigestSnoozeI   = tb.InlineButton{Unique: "digest_snooze"}
+	DigestOpenI     = tb.InlineButton{Unique: "digest_open"}
+	DigestSkipI     = tb.InlineButton{Unique: "digest_skip"}
+	DigestPageI     = tb.InlineButton{Unique: "digest_page"}
+)
+
+// DigestItem returns a button of the endpoint acting on the revise item shown on the digest page.
+func DigestItem(endpoint tb.InlineButton, itemID uuid.UUID, page int, text string) tb.InlineButton {
+	btn := endpoint
+	btn.Text = text
+	btn.Data = itemID.String() + "|" + strconv.Itoa(page)
+	return btn
+}
+
+// DigestPage returns a button switching the digest to the page.
+func DigestPage(page int, text string) tb.InlineButton {
+	btn := DigestPageI
+	btn.Text = text
+	btn.Data = strconv.Itoa(page)
+	return btn
+}
+
+// Item endpoints, the data of the item buttons is "<item id>|<list page>" followed by "|<arg>" if the endpoint
+// takes an argument, e.g. the grade of the review. The data of the list page buttons is "<page>".
+var (
+	ItemListPageI = tb.InlineButton{Unique: "item_list"}
+	ItemOpenI     = tb.InlineButton{Unique: "item_open"}
+	ItemReviewI   = tb.InlineButton{Unique: "item_review"}
+	ItemRenameI   = tb.InlineButton{Unique: "item_rename"}
+	ItemDescribeI = tb.InlineButton{Unique: "item_describe"}
+	ItemTagI      = tb.InlineButton{Unique: "item_tag"}
+	ItemUntagI    = tb.InlineButton{Unique: "item_untag"}
+	ItemDeleteI   = tb.InlineButton{Unique: "item_delete"}
+	ItemRestoreI  = tb.InlineButton{Unique: "item_restore"}
+)
+
+// Item returns a button of the endpoint acting on the revise item opened from the list page.
+func Item(endpoint tb.InlineButton, itemID uuid.UUID, page int, text string, arg ...string) tb.InlineButton {
+	btn := endpoint
+	btn.Text = text
+	btn.Data = strings.Join(append([]string{itemID.String(), strconv.Itoa(page)}, arg...), "|")
+	return btn
+}
+
+// ItemListPage returns a button switching the item list to the page.
+func ItemListPage(page int, text string) tb.InlineButton {
+	btn := ItemListPageI
+	btn.Text = text
+	btn.Data = strconv.Itoa(page)
+	return btn
+}
+
+// Create wizard endpoints, the data of the tag buttons is the tag, the other buttons have no data.
+var (
+	WizardSkipI   = tb.InlineButton{Unique: "wizard_skip"}
+	WizardBackI   = tb.InlineButton{Unique: "wizard_back"}
+	WizardCancelI = tb.InlineButton{Unique: "wizard_cancel"}
+	WizardTagI    = tb.InlineButton{Unique: "wizard_tag"}
+	WizardCreateI = tb.InlineButton{Unique: "wizard_create"}
+)
+
+// Wizard returns a button of the create wizard endpoint.
+func Wizard(endpoint tb.InlineButton, text string) tb.InlineButton {
+	btn := endpoint
+	btn.Text = text
+	return btn
+}
+
+// WizardTag returns a button picking the tag for the revise item being created.
+func WizardTag(tag, text string) tb.InlineButton {
+	btn := WizardTagI
+	btn.Text = text
+	btn.Data = tag
+	return btn
+}
+
+// TokenRevokeI is the endpoint of the api token revoke buttons, the data is the token id.
+var TokenRevokeI = tb.InlineButton{Unique: "token_revoke"}
+
+// TokenRevoke returns a button revoking the api token.
+func TokenRevoke(tokenID uuid.UUID, text string) tb.InlineButton {
+	btn := TokenRevokeI
+	btn.Text = text
+	btn.Data = tokenID.String()
+	return btn
+}
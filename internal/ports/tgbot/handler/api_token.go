@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ListAPITokens lists the active api tokens of the user, each with a button revoking it.
+func (h *Handler) ListAPITokens(c tb.Context) error {
+	op := errs.Op("handler.list_api_tokens")
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	text, markup, err := h.apiTokenList(h.printer(c), h.timezone(c), userID)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render api tokens")
+	}
+	return c.Reply(text, markup, tb.ModeMarkdownV2)
+}
+
+// CreateAPIToken creates an api token with the read scope unless write is given,
+// the token is shown only in the reply.
+//
+//	/token_create "obsidian sync" write
+func (h *Handler) CreateAPIToken(c tb.Context) error {
+	op := errs.Op("handler.create_api_token")
+
+	p := h.printer(c)
+	args := parseQuotedArgs(c.Message().Payload)
+	if len(args) < 1 || len(args) > 2 {
+		return c.Reply(
+			p.Sprintf("⚠️ *Usage:*")+"\n"+
+				"/token\\_create \"name\" \\[read\\|write\\]\n\n"+
+				p.Sprintf("*Example:*")+"\n"+
+				"/token\\_create \"obsidian sync\" write",
+			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+		)
+	}
+
+	scope := apitoken.ScopeRead
+	if len(args) == 2 {
+		var err error
+		scope, err = apitoken.ParseScope(args[1])
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse scope")
+		}
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	token, err := h.app.APIToken.Commands.CreateToken.Handle(
+		context.TODO(),
+		command.CreateToken{
+			ID:     apitoken.NewTokenID(),
+			UserID: userID,
+			Name:   args[0],
+			Scope:  scope,
+		},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create api token")
+	}
+
+	return c.Reply(
+		p.Sprintf("🔑 *API Token Created*")+"\n\n"+
+			p.Sprintf("*Name:* %s", escapeMarkdown(args[0]))+"\n"+
+			p.Sprintf("*Scope:* %s", scopeName(p, scope))+"\n\n"+
+			"`"+token+"`\n\n"+
+			p.Sprintf("_Copy it now, it is not shown again\\. Send it as_ `Authorization: Bearer <token>`"),
+		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+	)
+}
+
+// RevokeAPIToken revokes the api token of the button and refreshes the list.
+func (h *Handler) RevokeAPIToken(c tb.Context) error {
+	op := errs.Op("handler.revoke_api_token")
+
+	tokenID, err := uuid.FromString(c.Callback().Data)
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, err, "invalid api token id").
+			WithContext("data", c.Callback().Data)
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	err = h.app.APIToken.Commands.RevokeToken.Handle(
+		context.TODO(),
+		command.RevokeToken{ID: tokenID, UserID: userID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to revoke api token")
+	}
+
+	p := h.printer(c)
+	text, markup, err := h.apiTokenList(p, h.timezone(c), userID)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render api tokens")
+	}
+	if err := c.Respond(&tb.CallbackResponse{Text: p.Sprintf("Revoked")}); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(text, markup, tb.ModeMarkdownV2)
+}
+
+// apiTokenList renders the active api tokens of the user, the times are in the time zone.
+func (h *Handler) apiTokenList(p *message.Printer, tz valueobject.Timezone, userID uuid.UUID) (string, *tb.ReplyMarkup, error) {
+	op := errs.Op("handler.api_token_list")
+
+	tokens, err := h.app.APIToken.Queries.ListUserTokens.Handle(
+		context.TODO(),
+		query.ListUserTokens{UserID: userID},
+	)
+	if err != nil {
+		return "", nil, errs.WithOp(op, err, "failed to list api tokens")
+	}
+
+	msg := strings.Builder{}
+	msg.WriteString(p.Sprintf("🔑 *API Tokens*") + "\n\n")
+	if len(tokens) == 0 {
+		msg.WriteString(p.Sprintf("_You have no API tokens yet\\._") + "\n\n")
+	}
+	markup := &tb.ReplyMarkup{}
+	for _, token := range tokens {
+		msg.WriteString("*" + escapeMarkdown(token.Name) + "*\n")
+		msg.WriteString(p.Sprintf("• Scope: %s", scopeName(p, apitoken.Scope(token.Scope))) + "\n")
+		createdAt := tz.In(token.CreatedAt).Format(p.Sprintf(i18n.LayoutDateYear))
+		msg.WriteString(p.Sprintf("• Created: %s", escapeMarkdown(createdAt)) + "\n")
+		if token.LastUsedAt != nil {
+			lastUsedAt := tz.In(*token.LastUsedAt).Format(p.Sprintf(i18n.LayoutDateYearTime))
+			msg.WriteString(p.Sprintf("• Last used: %s", escapeMarkdown(lastUsedAt)) + "\n")
+		}
+		msg.WriteString("\n")
+		markup.InlineKeyboard = append(markup.InlineKeyboard, []tb.InlineButton{
+			button.TokenRevoke(token.ID, p.Sprintf("🗑 Revoke %s", token.Name)),
+		})
+	}
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/token\\_create \"name\" \\[read\\|write\\] \\- " +
+		p.Sprintf("use the token with the REST API outside Telegram"))
+
+	return msg.String(), markup, nil
+}
+
+// scopeName returns the localized name of the scope.
+func scopeName(p *message.Printer, scope apitoken.Scope) string {
+	if scope == apitoken.ScopeWrite {
+		return p.Sprintf("read and write")
+	}
+	return p.Sprintf("read only")
+}
@@ -0,0 +1,114 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Channels shows where the reminders are sent, or changes the channels if new ones are provided.
+// An email address or a webhook url turns the corresponding channel on, turning the webhook channel on
+// gets a new secret signing its payloads, it is shown only in the reply.
+//
+//	/channels telegram name@example.com
+//	/channels https://example.com/hooks/revise
+func (h *Handler) Channels(c tb.Context) error {
+	op := errs.Op("handler.channels")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	args := strings.Fields(c.Message().Payload)
+	var cmd command.ChangeSettings
+	if len(args) > 0 {
+		var err error
+		cmd, err = parseChannelsArgs(args)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse channels")
+		}
+		cmd.ChatID = chatID
+		if slices.Contains(*cmd.Channels, user.ChannelWebhook) {
+			cmd.WebhookSecret, err = user.NewWebhookSecret()
+			if err != nil {
+				return errs.WithOp(op, err, "failed to generate webhook secret")
+			}
+		}
+
+		if err := h.app.User.Commands.ChangeSettings.Handle(context.TODO(), cmd); err != nil {
+			return errs.WithOp(op, err, "failed to change channels")
+		}
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if len(args) > 0 {
+		msg.WriteString(p.Sprintf("✅ *Channels Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("📣 *Channels*") + "\n\n")
+	}
+	msg.WriteString(p.Sprintf("• Channels: %s", escapeMarkdown(strings.Join(queryUser.Settings.Channels, ", "))) + "\n")
+	if queryUser.Settings.Email != "" {
+		msg.WriteString(p.Sprintf("• Email: %s", escapeMarkdown(queryUser.Settings.Email)) + "\n")
+	}
+	if queryUser.Settings.WebhookURL != "" {
+		msg.WriteString(p.Sprintf("• Webhook: %s", escapeMarkdown(queryUser.Settings.WebhookURL)) + "\n")
+	}
+	// the secret of the webhook channel is shown only in this reply
+	if cmd.WebhookSecret != "" {
+		msg.WriteString("\n" + p.Sprintf("*Webhook secret:*") + " `" + cmd.WebhookSecret + "`\n")
+		msg.WriteString(p.Sprintf("_Copy it now, it is not shown again\\. Verify the X\\-Revise\\-Signature header of the payloads with it_") + "\n")
+	}
+	msg.WriteString("\n" + p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/channels telegram name@example\\.com\n")
+	msg.WriteString("/channels https://example\\.com/hooks/revise\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• Channels: telegram, email, webhook") + "\n")
+	msg.WriteString(p.Sprintf("• An email address or a webhook url turns its channel on"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
+
+func parseChannelsArgs(args []string) (command.ChangeSettings, error) {
+	op := errs.Op("handler.parse_channels_args")
+
+	var cmd command.ChangeSettings
+	channels := make([]user.Channel, 0, len(args))
+	for _, arg := range args {
+		var channel user.Channel
+		switch {
+		case strings.Contains(arg, "://"):
+			webhookURL := arg
+			cmd.WebhookURL = &webhookURL
+			channel = user.ChannelWebhook
+		case strings.Contains(arg, "@"):
+			email := arg
+			cmd.Email = &email
+			channel = user.ChannelEmail
+		default:
+			var err error
+			channel, err = user.ParseChannel(arg)
+			if err != nil {
+				return command.ChangeSettings{}, errs.WithOp(op, err, "failed to parse channel")
+			}
+		}
+		// "email name@example.com" names the email channel twice
+		if !slices.Contains(channels, channel) {
+			channels = append(channels, channel)
+		}
+	}
+	cmd.Channels = &channels
+	return cmd, nil
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Text handles the plain text messages, they are the answers to the conversation of the chat.
+// Out of a conversation a bare link creates a revise item of the linked page.
+func (h *Handler) Text(c tb.Context) error {
+	op := errs.Op("tgbot.handler.text")
+
+	// the forwarded messages are handled by Forward
+	if c.Message().Origin != nil {
+		return nil
+	}
+
+	conv, ok, err := h.conversation(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get conversation")
+	}
+	if !ok {
+		if link, ok := messageURL(c.Message()); ok {
+			return h.shareURL(c, link)
+		}
+		return nil
+	}
+
+	switch conv.Flow() {
+	case conversation.FlowCreateItem:
+		err = h.createItemStep(c, conv)
+	case conversation.FlowEditItem:
+		err = h.editItem(c, conv)
+	}
+	if err == nil {
+		return nil
+	}
+
+	// an incorrect answer keeps the conversation, so a corrected one can be sent right away
+	if !errs.IsErrorType(err, errs.ErrorTypeIncorrectInput) {
+		if deleteErr := h.conversations.Delete(context.TODO(), conv.ChatID()); deleteErr != nil {
+			return errs.WithOp(op, deleteErr, "failed to end conversation")
+		}
+	}
+	return errs.WithOp(op, err, "failed to handle conversation answer")
+}
+
+// Cancel ends the conversation of the chat.
+func (h *Handler) Cancel(c tb.Context) error {
+	op := errs.Op("tgbot.handler.cancel")
+
+	p := h.printer(c)
+	_, ok, err := h.conversation(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get conversation")
+	}
+	if !ok {
+		return c.Reply(p.Sprintf("Nothing to cancel"))
+	}
+	if err := h.conversations.Delete(context.TODO(), c.Chat().ID); err != nil {
+		return errs.WithOp(op, err, "failed to end conversation")
+	}
+	return c.Reply(p.Sprintf("Cancelled"))
+}
+
+// conversation returns the conversation of the chat, false if there is none or it is expired.
+func (h *Handler) conversation(c tb.Context) (conversation.Conversation, bool, error) {
+	op := errs.Op("tgbot.handler.conversation")
+
+	conv, err := h.conversations.Get(context.TODO(), c.Chat().ID)
+	if err != nil {
+		if errs.IsErrorType(err, errs.ErrorTypeNotFound) {
+			return conversation.Conversation{}, false, nil
+		}
+		return conversation.Conversation{}, false, errs.WithOp(op, err, "failed to get conversation")
+	}
+	if conv.Expired(time.Now()) {
+		return conversation.Conversation{}, false, nil
+	}
+	return conv, true, nil
+}
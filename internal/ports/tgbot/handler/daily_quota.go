@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// DailyQuota shows the daily quota of the user, or changes it if new limits are provided.
+//
+//	/quota 50 10
+func (h *Handler) DailyQuota(c tb.Context) error {
+	op := errs.Op("handler.daily_quota")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	args := strings.Fields(c.Message().Payload)
+	if len(args) > 0 {
+		cmd, err := parseDailyQuotaArgs(args)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse daily quota")
+		}
+		cmd.ChatID = chatID
+
+		if err := h.app.User.Commands.ChangeSettings.Handle(context.TODO(), cmd); err != nil {
+			return errs.WithOp(op, err, "failed to change daily quota")
+		}
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if len(args) > 0 {
+		msg.WriteString(p.Sprintf("✅ *Daily Quota Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("📊 *Daily Quota*") + "\n\n")
+	}
+	msg.WriteString(p.Sprintf("• Reviews: %s", formatDailyLimit(p, queryUser.Settings.DailyReviewLimit)) + "\n")
+	msg.WriteString(p.Sprintf("• New items: %s", formatDailyLimit(p, queryUser.Settings.DailyNewItemLimit)) + "\n\n")
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString(p.Sprintf("/quota \\<reviews\\> \\[new items\\]") + "\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• 0 means no limit") + "\n")
+	msg.WriteString(p.Sprintf("• Items over the quota are carried over to the next day, the most overdue first"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
+
+func parseDailyQuotaArgs(args []string) (command.ChangeSettings, error) {
+	op := errs.Op("handler.parse_daily_quota_args")
+	if len(args) > 2 {
+		return command.ChangeSettings{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "too many arguments").
+			WithMessages([]errs.Message{{Key: "message", Value: "usage: /quota <reviews> [new items]"}})
+	}
+
+	limits := make([]*int, 0, len(args))
+	for _, arg := range args {
+		limit, err := strconv.Atoi(arg)
+		if err != nil {
+			return command.ChangeSettings{}, errs.
+				NewIncorrectInputError(op, err, "invalid daily limit").
+				WithMessages([]errs.Message{{Key: "message", Value: "daily limits must be numbers"}}).
+				WithContext("limit", arg)
+		}
+		limits = append(limits, &limit)
+	}
+
+	cmd := command.ChangeSettings{DailyReviewLimit: limits[0]}
+	if len(limits) == 2 {
+		cmd.DailyNewItemLimit = limits[1]
+	}
+	return cmd, nil
+}
+
+func formatDailyLimit(p *message.Printer, limit int) string {
+	if limit == 0 {
+		return p.Sprintf("no limit")
+	}
+	return strconv.Itoa(limit)
+}
@@ -0,0 +1,282 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+const (
+	// digestPageSize is the number of revise items shown on a digest page,
+	// it keeps the message and its keyboard well within the telegram limits.
+	digestPageSize = 5
+	// digestDescriptionLength is the number of runes of the description shown in the digest.
+	digestDescriptionLength = 100
+
+	digestSnooze = time.Hour
+	// digestSkip postpones the item to the next day.
+	digestSkip = 24 * time.Hour
+)
+
+// DigestItem is a revise item listed in the digest of due items.
+type DigestItem struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Tags        valueobject.Tags
+}
+
+// DigestItemsFromDomain converts the due revise items to the digest items.
+func DigestItemsFromDomain(reviseItems []reviseitem.ReviseItem) []DigestItem {
+	items := make([]DigestItem, len(reviseItems))
+	for i := range reviseItems {
+		items[i] = DigestItem{
+			ID:          reviseItems[i].ID(),
+			Name:        reviseItems[i].Name(),
+			Description: reviseItems[i].Description(),
+			Tags:        reviseItems[i].Tags(),
+		}
+	}
+	return items
+}
+
+func digestItemsFromQuery(reviseItems []query.ReviseItem) []DigestItem {
+	items := make([]DigestItem, len(reviseItems))
+	for i, item := range reviseItems {
+		items[i] = DigestItem{
+			ID:          item.ID,
+			Name:        item.Name,
+			Description: item.Description,
+			Tags:        item.Tags,
+		}
+	}
+	return items
+}
+
+// Digest renders a page of the digest of the due revise items as a MarkdownV2 message in the language of the printer,
+// every item gets the reviewed, snooze, open and skip buttons.
+// The page is clamped to the available pages.
+func Digest(p *message.Printer, firstName string, items []DigestItem, page int) (string, *tb.ReplyMarkup) {
+	msg := strings.Builder{}
+	msg.WriteString(p.Sprintf("Hello, %s\\!", escapeMarkdown(firstName)) + "\n")
+	if len(items) == 0 {
+		msg.WriteString(p.Sprintf("🎉 You are done for today, no revise items are due\\."))
+		return msg.String(), &tb.ReplyMarkup{}
+	}
+
+	pages := (len(items) + digestPageSize - 1) / digestPageSize
+	page = max(0, min(page, pages-1))
+	start := page * digestPageSize
+	end := min(start+digestPageSize, len(items))
+
+	msg.WriteString(p.Sprintf("📚 You have *%d* revise items due:", len(items)) + "\n\n")
+
+	keyboard := make([][]tb.InlineButton, 0, end-start+1)
+	for i, item := range items[start:end] {
+		n := strconv.Itoa(start + i + 1)
+		msg.WriteString(fmt.Sprintf("*%s\\.* %s\n", n, escapeMarkdown(item.Name)))
+		if item.Description != "" {
+			msg.WriteString(fmt.Sprintf("    _%s_\n", escapeMarkdown(truncate(item.Description, digestDescriptionLength))))
+		}
+		if !item.Tags.IsEmpty() {
+			msg.WriteString(fmt.Sprintf("    %s\n", escapeMarkdown(item.Tags.String())))
+		}
+
+		keyboard = append(keyboard, []tb.InlineButton{
+			button.DigestItem(button.DigestReviewedI, item.ID, page, "✅ "+n),
+			button.DigestItem(button.DigestSnoozeI, item.ID, page, "⏰ "+n),
+			button.DigestItem(button.DigestOpenI, item.ID, page, "📖 "+n),
+			button.DigestItem(button.DigestSkipI, item.ID, page, "⏭ "+n),
+		})
+	}
+	msg.WriteString("\n" + p.Sprintf("✅ reviewed · ⏰ snooze for an hour · 📖 open · ⏭ skip until tomorrow"))
+
+	if pages > 1 {
+		msg.WriteString("\n\n" + p.Sprintf("Page %d/%d", page+1, pages))
+		nav := make([]tb.InlineButton, 0, 2)
+		if page > 0 {
+			nav = append(nav, button.DigestPage(page-1, p.Sprintf("◀️ Previous")))
+		}
+		if page < pages-1 {
+			nav = append(nav, button.DigestPage(page+1, p.Sprintf("Next ▶️")))
+		}
+		keyboard = append(keyboard, nav)
+	}
+
+	return msg.String(), &tb.ReplyMarkup{InlineKeyboard: keyboard}
+}
+
+// DigestReviewed reviews the revise item of the digest with the good grade.
+func (h *Handler) DigestReviewed(c tb.Context) error {
+	op := errs.Op("tgbot.handler.digest_reviewed")
+
+	itemID, page, err := parseDigestItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse digest data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Review.Handle(context.TODO(), command.Review{
+		ID:     itemID,
+		UserID: userID,
+		Grade:  valueobject.GradeGood,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to review revise item")
+	}
+
+	p := h.printer(c)
+	notice := p.Sprintf("Reviewed, next revision on %s", h.timezone(c).In(nextRevisionAt).Format(p.Sprintf(i18n.LayoutDate)))
+	return h.refreshDigest(c, userID, page, notice)
+}
+
+// DigestSnooze postpones the revise item of the digest for an hour.
+func (h *Handler) DigestSnooze(c tb.Context) error {
+	return h.postponeDigestItem(c, errs.Op("tgbot.handler.digest_snooze"), digestSnooze)
+}
+
+// DigestSkip postpones the revise item of the digest until tomorrow.
+func (h *Handler) DigestSkip(c tb.Context) error {
+	return h.postponeDigestItem(c, errs.Op("tgbot.handler.digest_skip"), digestSkip)
+}
+
+func (h *Handler) postponeDigestItem(c tb.Context, op errs.Op, duration time.Duration) error {
+	itemID, page, err := parseDigestItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse digest data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Postpone.Handle(context.TODO(), command.Postpone{
+		ID:       itemID,
+		UserID:   userID,
+		Duration: duration,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to postpone revise item")
+	}
+
+	p := h.printer(c)
+	notice := p.Sprintf("Postponed until %s", h.timezone(c).In(nextRevisionAt).Format(p.Sprintf(i18n.LayoutDateTime)))
+	return h.refreshDigest(c, userID, page, notice)
+}
+
+// DigestOpen sends the revise item of the digest with the buttons managing it.
+func (h *Handler) DigestOpen(c tb.Context) error {
+	op := errs.Op("tgbot.handler.digest_open")
+
+	itemID, _, err := parseDigestItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse digest data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	text, markup := itemView(h.printer(c), h.timezone(c), item, 1)
+	return c.Send(text, markup, tb.ModeMarkdownV2)
+}
+
+// DigestPage switches the digest to another page.
+func (h *Handler) DigestPage(c tb.Context) error {
+	op := errs.Op("tgbot.handler.digest_page")
+
+	page, err := strconv.Atoi(c.Callback().Data)
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, err, "invalid digest page").
+			WithContext("data", c.Callback().Data)
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	return h.refreshDigest(c, userID, page, "")
+}
+
+// refreshDigest responds to the callback with the notice and re-renders the digest page with the items due now,
+// the snoozed items are due later today and are left out until they are due again.
+func (h *Handler) refreshDigest(c tb.Context, userID uuid.UUID, page int, notice string) error {
+	op := errs.Op("tgbot.handler.refresh_digest")
+
+	if err := c.Respond(&tb.CallbackResponse{Text: notice}); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+
+	due, err := h.app.ReviseItem.Query.ListDueReviseItems.Handle(
+		context.TODO(),
+		query.ListDueReviseItems{UserID: userID, DueBy: time.Now()},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to list due revise items")
+	}
+
+	text, markup := Digest(h.printer(c), c.Sender().FirstName, digestItemsFromQuery(due.Items), page)
+	return c.Edit(text, markup, tb.ModeMarkdownV2)
+}
+
+func parseDigestItemData(data string) (uuid.UUID, int, error) {
+	op := errs.Op("tgbot.handler.parse_digest_item_data")
+
+	rawID, rawPage, ok := strings.Cut(data, "|")
+	if !ok {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid digest data").
+			WithContext("data", data)
+	}
+	itemID, err := uuid.FromString(rawID)
+	if err != nil {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, err, "invalid revise item id").
+			WithContext("data", data)
+	}
+	page, err := strconv.Atoi(rawPage)
+	if err != nil {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, err, "invalid digest page").
+			WithContext("data", data)
+	}
+	return itemID, page, nil
+}
+
+// truncate shortens the text to the number of runes, marking the cut with an ellipsis.
+func truncate(text string, length int) string {
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:length-1])) + "…"
+}
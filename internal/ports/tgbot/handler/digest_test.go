@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+)
+
+func TestDigest(t *testing.T) {
+	t.Parallel()
+
+	p := i18n.Printer(valueobject.SupportedLanguages[0])
+	items := make([]DigestItem, 12)
+	for i := range items {
+		items[i] = DigestItem{
+			ID:   uuid.Must(uuid.NewV4()),
+			Name: fmt.Sprintf("Item %d", i+1),
+		}
+	}
+
+	t.Run("Expect no items", func(t *testing.T) {
+		text, markup := Digest(p, "Rob", nil, 0)
+		assert.Contains(t, text, "no revise items are due")
+		assert.Empty(t, markup.InlineKeyboard)
+	})
+
+	t.Run("Expect first page", func(t *testing.T) {
+		text, markup := Digest(p, "Rob", items, 0)
+		assert.Contains(t, text, "*12*")
+		assert.Contains(t, text, "*1\\.* Item 1\n")
+		assert.Contains(t, text, "*5\\.* Item 5\n")
+		assert.NotContains(t, text, "Item 6")
+		assert.Contains(t, text, "Page 1/3")
+		// the items and the navigation
+		require.Len(t, markup.InlineKeyboard, digestPageSize+1)
+		assert.Equal(t, items[0].ID.String()+"|0", markup.InlineKeyboard[0][0].Data)
+		require.Len(t, markup.InlineKeyboard[digestPageSize], 1)
+		assert.Equal(t, "1", markup.InlineKeyboard[digestPageSize][0].Data)
+	})
+
+	t.Run("Expect page after the last to be clamped", func(t *testing.T) {
+		text, markup := Digest(p, "Rob", items, 10)
+		assert.Contains(t, text, "*11\\.* Item 11\n")
+		assert.Contains(t, text, "*12\\.* Item 12\n")
+		assert.Contains(t, text, "Page 3/3")
+		require.Len(t, markup.InlineKeyboard, 3)
+		assert.Equal(t, items[10].ID.String()+"|2", markup.InlineKeyboard[0][0].Data)
+		require.Len(t, markup.InlineKeyboard[2], 1)
+		assert.Equal(t, "1", markup.InlineKeyboard[2][0].Data)
+	})
+
+	t.Run("Expect negative page to be clamped", func(t *testing.T) {
+		text, _ := Digest(p, "Rob", items, -1)
+		assert.Contains(t, text, "*1\\.* Item 1\n")
+		assert.Contains(t, text, "Page 1/3")
+	})
+
+	t.Run("Expect single page without navigation", func(t *testing.T) {
+		text, markup := Digest(p, "Rob", items[:2], 0)
+		assert.NotContains(t, text, "Page")
+		assert.Len(t, markup.InlineKeyboard, 2)
+	})
+
+	t.Run("Expect names to be escaped", func(t *testing.T) {
+		item := DigestItem{
+			ID:          uuid.Must(uuid.NewV4()),
+			Name:        "a.b_c*d [e](f)!",
+			Description: "x-y=z",
+			Tags:        valueobject.NewTags("go"),
+		}
+		text, _ := Digest(p, "R.o_b", []DigestItem{item}, 0)
+		assert.Contains(t, text, "Hello, R\\.o\\_b\\!")
+		assert.Contains(t, text, "*1\\.* a\\.b\\_c\\*d \\[e\\]\\(f\\)\\!\n")
+		assert.Contains(t, text, "    _x\\-y\\=z_\n")
+	})
+}
+
+func TestParseDigestItemData(t *testing.T) {
+	t.Parallel()
+
+	itemID := uuid.FromStringOrNil("d7accc08-981f-4aa7-8477-b1840b9a2611")
+
+	t.Run("Expect id and page", func(t *testing.T) {
+		id, page, err := parseDigestItemData(itemID.String() + "|2")
+		require.NoError(t, err)
+		assert.Equal(t, itemID, id)
+		assert.Equal(t, 2, page)
+	})
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "Empty", data: ""},
+		{name: "Without separator", data: itemID.String()},
+		{name: "Invalid id", data: "not-a-uuid|0"},
+		{name: "Empty id", data: "|0"},
+		{name: "Invalid page", data: itemID.String() + "|one"},
+		{name: "Empty page", data: itemID.String() + "|"},
+		{name: "Extra part", data: itemID.String() + "|0|1"},
+	}
+	for _, tt := range tests {
+		t.Run("Expect error for "+tt.name, func(t *testing.T) {
+			_, _, err := parseDigestItemData(tt.data)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		text   string
+		length int
+		want   string
+	}{
+		{name: "Short", text: "hello", length: 5, want: "hello"},
+		{name: "Long", text: "hello world", length: 6, want: "hello…"},
+		{name: "Cyrillic", text: "Привет, мир", length: 7, want: "Привет…"},
+		{name: "Cyrillic within length", text: "Сәлем", length: 5, want: "Сәлем"},
+		{name: "Emoji", text: "📚📚📚📚", length: 3, want: "📚📚…"},
+		{name: "Trailing space", text: "ab cd", length: 4, want: "ab…"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.text, tt.length)
+			assert.Equal(t, tt.want, got)
+			assert.LessOrEqual(t, len([]rune(got)), tt.length)
+			assert.True(t, strings.HasPrefix(tt.text, strings.TrimSuffix(got, "…")))
+		})
+	}
+}
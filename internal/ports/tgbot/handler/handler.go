@@ -1,11 +1,115 @@
 package handler
 
-import "github.com/ARUMANDESU/go-revise/internal/application"
+import (
+	"context"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
 
 type Handler struct {
-	app application.Application
+	app           application.Application
+	conversations conversation.Repository
+}
+
+func NewHandler(app application.Application, conversations conversation.Repository) *Handler {
+	return &Handler{app: app, conversations: conversations}
+}
+
+// timezoneKey is the context key of the time zone of the user.
+const timezoneKey = "timezone"
+
+// Localize is a middleware storing the printer of the language and the time zone of the user in the context,
+// the users who are not registered yet get the language of their telegram client and UTC.
+func (h *Handler) Localize(next tb.HandlerFunc) tb.HandlerFunc {
+	return func(c tb.Context) error {
+		p, tz := h.userLocale(c)
+		c.Set(i18n.ContextKey, p)
+		c.Set(timezoneKey, tz)
+		return next(c)
+	}
+}
+
+// printer returns the printer of the language of the user chatting with the bot.
+func (h *Handler) printer(c tb.Context) *message.Printer {
+	return i18n.FromContext(c)
+}
+
+// timezone returns the time zone of the user chatting with the bot, the times shown to the user are in it.
+func (h *Handler) timezone(c tb.Context) valueobject.Timezone {
+	if tz, ok := c.Get(timezoneKey).(valueobject.Timezone); ok {
+		return tz
+	}
+	return valueobject.UTC()
+}
+
+func (h *Handler) userLocale(c tb.Context) (*message.Printer, valueobject.Timezone) {
+	chatID, ok := userChatID(c)
+	if !ok {
+		return i18n.ClientPrinter(c), valueobject.UTC()
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return i18n.ClientPrinter(c), valueobject.UTC()
+	}
+
+	tz, err := valueobject.ParseTimezone(queryUser.Settings.Timezone)
+	if err != nil {
+		tz = valueobject.UTC()
+	}
+	tag, err := language.Parse(queryUser.Settings.Language)
+	if err != nil {
+		return i18n.ClientPrinter(c), tz
+	}
+	return i18n.Printer(tag), tz
+}
+
+// userID returns the id of the registered user chatting with the bot.
+func (h *Handler) userID(c tb.Context) (uuid.UUID, error) {
+	op := errs.Op("handler.user_id")
+
+	chatID, ok := userChatID(c)
+	if !ok {
+		return uuid.Nil, errs.NewIncorrectInputError(op, errs.ErrInvalidInput, "update has no chat nor sender")
+	}
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return uuid.Nil, errs.WithOp(op, err, "failed to get user")
+	}
+
+	userID, err := uuid.FromString(queryUser.ID)
+	if err != nil {
+		return uuid.Nil, errs.WithOp(op, err, "failed to parse user ID")
+	}
+	return userID, nil
 }
 
-func NewHandler(app application.Application) *Handler {
-	return &Handler{app: app}
+// userChatID returns the id of the private chat of the user with the bot.
+// The inline queries come without a chat, the id of the private chat is the one of the sender then.
+func userChatID(c tb.Context) (user.TelegramID, bool) {
+	switch {
+	case c.Chat() != nil:
+		return user.TelegramID(c.Chat().ID), true
+	case c.Sender() != nil:
+		return user.TelegramID(c.Sender().ID), true
+	default:
+		return 0, false
+	}
 }
@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ListIntervalProfiles lists the interval profiles of the user.
+func (h *Handler) ListIntervalProfiles(c tb.Context) error {
+	op := errs.Op("handler.list_interval_profiles")
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	profiles, err := h.app.IntervalProfile.Queries.ListUserIntervalProfiles.Handle(
+		context.TODO(),
+		query.ListUserIntervalProfiles{UserID: userID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to list interval profiles")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	msg.WriteString(p.Sprintf("🗂 *Interval Profiles*") + "\n\n")
+	if len(profiles) == 0 {
+		msg.WriteString(p.Sprintf("_You have no interval profiles yet\\._") + "\n\n")
+	}
+	for _, profile := range profiles {
+		msg.WriteString("*" + escapeMarkdown(profile.Name) + "*\n")
+		msg.WriteString("`" + profile.Intervals + "`\n")
+		if len(profile.Tags) > 0 {
+			msg.WriteString(p.Sprintf("*Tags:* %s", escapeMarkdown(strings.Join(profile.Tags, ", "))) + "\n")
+		}
+		msg.WriteString("\n")
+	}
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/profile\\_create \"name\" \"1h 1d 3d\"\n")
+	msg.WriteString("/profile\\_tag \"tag\" \\[\"name\"\\] \\- " + p.Sprintf("attach, or detach without a name") + "\n")
+	msg.WriteString("/profile\\_delete \"name\"")
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
+
+// CreateIntervalProfile creates a named interval profile.
+//
+//	/profile_create "vocabulary" "1h 6h 1d 3d"
+func (h *Handler) CreateIntervalProfile(c tb.Context) error {
+	op := errs.Op("handler.create_interval_profile")
+
+	p := h.printer(c)
+	args := parseQuotedArgs(c.Message().Payload)
+	if len(args) != 2 {
+		return c.Reply(
+			p.Sprintf("⚠️ *Usage:*")+"\n"+
+				"/profile\\_create \"name\" \"intervals\"\n\n"+
+				p.Sprintf("*Example:*")+"\n"+
+				"/profile\\_create \"vocabulary\" \"1h 6h 1d 3d\"",
+			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+		)
+	}
+
+	intervals, err := valueobject.ParseReviewInterval(args[1])
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse intervals")
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	err = h.app.IntervalProfile.Commands.CreateProfile.Handle(
+		context.TODO(),
+		command.CreateProfile{
+			ID:        intervalprofile.NewProfileID(),
+			UserID:    userID,
+			Name:      args[0],
+			Intervals: intervals,
+		},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create interval profile")
+	}
+
+	return c.Reply(
+		p.Sprintf("✅ *Interval Profile Created*")+"\n\n"+
+			p.Sprintf("*Name:* %s", escapeMarkdown(args[0]))+"\n"+
+			"`"+intervals.String()+"`",
+		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+	)
+}
+
+// TagIntervalProfile attaches the interval profile to a tag, or detaches it if no profile is given.
+//
+//	/profile_tag "english" "vocabulary"
+func (h *Handler) TagIntervalProfile(c tb.Context) error {
+	op := errs.Op("handler.tag_interval_profile")
+
+	p := h.printer(c)
+	args := parseQuotedArgs(c.Message().Payload)
+	if len(args) < 1 || len(args) > 2 {
+		return c.Reply(
+			p.Sprintf("⚠️ *Usage:*")+"\n"+
+				"/profile\\_tag \"tag\" \\[\"name\"\\]\n\n"+
+				p.Sprintf("*Example:*")+"\n"+
+				"/profile\\_tag \"english\" \"vocabulary\"",
+			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+		)
+	}
+	tag := args[0]
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	if len(args) == 1 {
+		err = h.app.IntervalProfile.Commands.DetachFromTag.Handle(
+			context.TODO(),
+			command.DetachFromTag{UserID: userID, Tag: tag},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to detach interval profile from tag")
+		}
+		return c.Reply(
+			p.Sprintf("✅ *Interval profile detached from* %s", escapeMarkdown(tag)),
+			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+		)
+	}
+
+	profileID, err := h.intervalProfileIDByName(userID, args[1])
+	if err != nil {
+		return errs.WithOp(op, err, "failed to find interval profile")
+	}
+
+	err = h.app.IntervalProfile.Commands.AttachToTag.Handle(
+		context.TODO(),
+		command.AttachToTag{ProfileID: profileID, UserID: userID, Tag: tag},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to attach interval profile to tag")
+	}
+
+	return c.Reply(
+		p.Sprintf("✅ *%s* is attached to %s", escapeMarkdown(args[1]), escapeMarkdown(tag)),
+		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+	)
+}
+
+// DeleteIntervalProfile deletes the interval profile with the given name.
+//
+//	/profile_delete "vocabulary"
+func (h *Handler) DeleteIntervalProfile(c tb.Context) error {
+	op := errs.Op("handler.delete_interval_profile")
+
+	p := h.printer(c)
+	args := parseQuotedArgs(c.Message().Payload)
+	if len(args) != 1 {
+		return c.Reply(
+			p.Sprintf("⚠️ *Usage:*")+"\n/profile\\_delete \"name\"",
+			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+		)
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user ID")
+	}
+
+	profileID, err := h.intervalProfileIDByName(userID, args[0])
+	if err != nil {
+		return errs.WithOp(op, err, "failed to find interval profile")
+	}
+
+	err = h.app.IntervalProfile.Commands.DeleteProfile.Handle(
+		context.TODO(),
+		command.DeleteProfile{ID: profileID, UserID: userID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to delete interval profile")
+	}
+
+	return c.Reply(
+		p.Sprintf("🗑 *Interval Profile Deleted*"),
+		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
+	)
+}
+
+func (h *Handler) intervalProfileIDByName(userID uuid.UUID, name string) (uuid.UUID, error) {
+	op := errs.Op("handler.interval_profile_id_by_name")
+
+	profiles, err := h.app.IntervalProfile.Queries.ListUserIntervalProfiles.Handle(
+		context.TODO(),
+		query.ListUserIntervalProfiles{UserID: userID},
+	)
+	if err != nil {
+		return uuid.Nil, errs.WithOp(op, err, "failed to list interval profiles")
+	}
+
+	for _, p := range profiles {
+		if p.Name == strings.TrimSpace(name) {
+			return p.ID, nil
+		}
+	}
+	return uuid.Nil, errs.
+		NewNotFound(op, nil, "interval profile not found").
+		WithMessages([]errs.Message{{Key: "message", Value: "interval profile not found"}}).
+		WithContext("name", name)
+}
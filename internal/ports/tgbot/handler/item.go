@@ -0,0 +1,422 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+const (
+	// itemListPageSize is the number of revise items shown on a list page.
+	itemListPageSize = 8
+	// itemButtonLength is the number of runes of the name shown on the item buttons of the list.
+	itemButtonLength = 30
+)
+
+// ListItems lists the revise items of the user, the newest first.
+//
+//	/list 2
+func (h *Handler) ListItems(c tb.Context) error {
+	op := errs.Op("handler.list_items")
+
+	page := 1
+	if payload := strings.TrimSpace(c.Message().Payload); payload != "" {
+		var err error
+		page, err = strconv.Atoi(payload)
+		if err != nil || page < 1 {
+			return errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid page").
+				WithMessages([]errs.Message{{Key: "message", Value: "page must be a positive number"}}).
+				WithContext("page", payload)
+		}
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	text, markup, err := h.itemList(c, userID, page)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render item list")
+	}
+	return c.Reply(text, markup, tb.ModeMarkdownV2)
+}
+
+// ItemListPage switches the item list to another page.
+func (h *Handler) ItemListPage(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_list_page")
+
+	page, err := strconv.Atoi(c.Callback().Data)
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, err, "invalid item list page").
+			WithContext("data", c.Callback().Data)
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	text, markup, err := h.itemList(c, userID, page)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render item list")
+	}
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(text, markup, tb.ModeMarkdownV2)
+}
+
+// ItemOpen shows the revise item with the buttons managing it.
+func (h *Handler) ItemOpen(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_open")
+
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	return h.refreshItem(c, userID, data, "")
+}
+
+// ItemReview reviews the revise item with the grade of the button.
+func (h *Handler) ItemReview(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_review")
+
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	grade, err := valueobject.ParseGrade(data.arg)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse grade")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Review.Handle(context.TODO(), command.Review{
+		ID:     data.itemID,
+		UserID: userID,
+		Grade:  grade,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to review revise item")
+	}
+
+	p := h.printer(c)
+	notice := p.Sprintf("Reviewed, next revision on %s", h.timezone(c).In(nextRevisionAt).Format(p.Sprintf(i18n.LayoutDate)))
+	return h.refreshItem(c, userID, data, notice)
+}
+
+// ItemUntag shows a button per tag of the revise item, or removes the tag of the button.
+func (h *Handler) ItemUntag(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_untag")
+
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     data.itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+	tags := item.Tags.StringArray()
+
+	p := h.printer(c)
+	if data.arg == "" {
+		keyboard := make([][]tb.InlineButton, 0, len(tags)+1)
+		for i, tag := range tags {
+			keyboard = append(keyboard, []tb.InlineButton{
+				button.Item(button.ItemUntagI, item.ID, data.page, "✖️ "+tag, strconv.Itoa(i)),
+			})
+		}
+		keyboard = append(keyboard, []tb.InlineButton{
+			button.Item(button.ItemOpenI, item.ID, data.page, p.Sprintf("◀️ Back")),
+		})
+		if err := c.Respond(); err != nil {
+			return errs.WithOp(op, err, "failed to respond to callback")
+		}
+		return c.Edit(&tb.ReplyMarkup{InlineKeyboard: keyboard})
+	}
+
+	// the tags are stored in order, so the index of the button still points to the same tag
+	index, err := strconv.Atoi(data.arg)
+	if err != nil || index < 0 || index >= len(tags) {
+		return errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid tag index").
+			WithMessages([]errs.Message{{Key: "message", Value: "the tag is already removed"}}).
+			WithContext("data", c.Callback().Data)
+	}
+	tag := tags[index]
+
+	err = h.app.ReviseItem.Command.RemoveTags.Handle(context.TODO(), command.RemoveTags{
+		ID:     item.ID,
+		UserID: userID,
+		Tags:   valueobject.NewTags(tag),
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to remove tag")
+	}
+
+	return h.refreshItem(c, userID, data, p.Sprintf("Removed the %s tag", tag))
+}
+
+// ItemDelete deletes the revise item, the reply has a button to undo it.
+func (h *Handler) ItemDelete(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_delete")
+
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     data.itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	err = h.app.ReviseItem.Command.DeleteReviseItem.Handle(context.TODO(), command.DeleteReviseItem{
+		ID:     item.ID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to delete revise item")
+	}
+
+	p := h.printer(c)
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(
+		p.Sprintf("🗑 *%s* is deleted", escapeMarkdown(item.Name)),
+		&tb.ReplyMarkup{InlineKeyboard: [][]tb.InlineButton{{
+			button.Item(button.ItemRestoreI, item.ID, data.page, p.Sprintf("↩️ Undo")),
+			button.ItemListPage(data.page, p.Sprintf("📋 Back to list")),
+		}}},
+		tb.ModeMarkdownV2,
+	)
+}
+
+// ItemRestore restores the deleted revise item.
+func (h *Handler) ItemRestore(c tb.Context) error {
+	op := errs.Op("tgbot.handler.item_restore")
+
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	err = h.app.ReviseItem.Command.RestoreReviseItem.Handle(context.TODO(), command.RestoreReviseItem{
+		ID:     data.itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to restore revise item")
+	}
+
+	return h.refreshItem(c, userID, data, h.printer(c).Sprintf("Restored"))
+}
+
+// itemList renders the page of the list of the revise items of the user.
+func (h *Handler) itemList(c tb.Context, userID uuid.UUID, page int) (string, *tb.ReplyMarkup, error) {
+	op := errs.Op("tgbot.handler.item_list")
+
+	items, meta, err := h.app.ReviseItem.Query.ListUserReviseItems.Handle(
+		context.TODO(),
+		query.ListUserReviseItems{
+			UserID:     userID,
+			Pagination: query.Pagination{Page: page, PageSize: itemListPageSize},
+		},
+	)
+	if err != nil {
+		return "", nil, errs.WithOp(op, err, "failed to list revise items")
+	}
+	// the last item of the last page was deleted, show the new last page
+	if len(items) == 0 && page > 1 {
+		return h.itemList(c, userID, page-1)
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	msg.WriteString(p.Sprintf("📋 *Revise Items*") + "\n\n")
+	if len(items) == 0 {
+		msg.WriteString(p.Sprintf("_You have no revise items yet\\._") + "\n")
+		msg.WriteString(p.Sprintf("Create one with /revise\\_create"))
+		return msg.String(), &tb.ReplyMarkup{}, nil
+	}
+
+	dateLayout := p.Sprintf(i18n.LayoutDate)
+	tz := h.timezone(c)
+	keyboard := make([][]tb.InlineButton, 0, len(items)+1)
+	for i, item := range items {
+		n := strconv.Itoa((page-1)*itemListPageSize + i + 1)
+		msg.WriteString(fmt.Sprintf("*%s\\.* %s\n", n, escapeMarkdown(item.Name)))
+		msg.WriteString("    " + p.Sprintf("_due %s_", escapeMarkdown(tz.In(item.NextRevisionAt).Format(dateLayout))) + "\n")
+
+		keyboard = append(keyboard, []tb.InlineButton{
+			button.Item(button.ItemOpenI, item.ID, page, n+". "+truncate(item.Name, itemButtonLength)),
+		})
+	}
+
+	if meta.LastPage > 1 {
+		msg.WriteString("\n" + p.Sprintf("Page %d/%d", page, meta.LastPage))
+		nav := make([]tb.InlineButton, 0, 2)
+		if page > 1 {
+			nav = append(nav, button.ItemListPage(page-1, p.Sprintf("◀️ Previous")))
+		}
+		if page < meta.LastPage {
+			nav = append(nav, button.ItemListPage(page+1, p.Sprintf("Next ▶️")))
+		}
+		keyboard = append(keyboard, nav)
+	}
+
+	return msg.String(), &tb.ReplyMarkup{InlineKeyboard: keyboard}, nil
+}
+
+// refreshItem responds to the callback with the notice and re-renders the revise item in the message.
+func (h *Handler) refreshItem(c tb.Context, userID uuid.UUID, data itemData, notice string) error {
+	op := errs.Op("tgbot.handler.refresh_item")
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     data.itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	if err := c.Respond(&tb.CallbackResponse{Text: notice}); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	text, markup := itemView(h.printer(c), h.timezone(c), item, data.page)
+	return c.Edit(text, markup, tb.ModeMarkdownV2)
+}
+
+// itemView renders the revise item with the buttons to review, edit and delete it, the times are in the time zone.
+func itemView(p *message.Printer, tz valueobject.Timezone, item query.ReviseItem, page int) (string, *tb.ReplyMarkup) {
+	msg := strings.Builder{}
+	msg.WriteString(itemDetails(p, tz, item) + "\n\n")
+	msg.WriteString(p.Sprintf("_Rate how well you remember it to mark it reviewed_"))
+
+	keyboard := [][]tb.InlineButton{
+		{
+			button.Item(button.ItemReviewI, item.ID, page, p.Sprintf("😣 Again"), strconv.Itoa(int(valueobject.GradeAgain))),
+			button.Item(button.ItemReviewI, item.ID, page, p.Sprintf("😐 Hard"), strconv.Itoa(int(valueobject.GradeHard))),
+			button.Item(button.ItemReviewI, item.ID, page, p.Sprintf("🙂 Good"), strconv.Itoa(int(valueobject.GradeGood))),
+			button.Item(button.ItemReviewI, item.ID, page, p.Sprintf("😄 Easy"), strconv.Itoa(int(valueobject.GradeEasy))),
+		},
+		{
+			button.Item(button.ItemRenameI, item.ID, page, p.Sprintf("✏️ Rename")),
+			button.Item(button.ItemDescribeI, item.ID, page, p.Sprintf("📝 Description")),
+		},
+	}
+	tagRow := []tb.InlineButton{button.Item(button.ItemTagI, item.ID, page, p.Sprintf("🏷 Add tags"))}
+	if !item.Tags.IsEmpty() {
+		tagRow = append(tagRow, button.Item(button.ItemUntagI, item.ID, page, p.Sprintf("✖️ Remove tags")))
+	}
+	keyboard = append(keyboard, tagRow, []tb.InlineButton{
+		button.Item(button.ItemDeleteI, item.ID, page, p.Sprintf("🗑 Delete")),
+		button.ItemListPage(page, p.Sprintf("📋 Back to list")),
+	})
+
+	return msg.String(), &tb.ReplyMarkup{InlineKeyboard: keyboard}
+}
+
+// itemDetails renders the name, description, tags, source and schedule of the revise item as MarkdownV2.
+func itemDetails(p *message.Printer, tz valueobject.Timezone, item query.ReviseItem) string {
+	msg := strings.Builder{}
+	msg.WriteString(fmt.Sprintf("📖 *%s*\n\n", escapeMarkdown(item.Name)))
+	if item.Description != "" {
+		msg.WriteString(fmt.Sprintf("%s\n\n", escapeMarkdown(item.Description)))
+	}
+	if !item.Tags.IsEmpty() {
+		msg.WriteString(p.Sprintf("• Tags: %s", escapeMarkdown(item.Tags.String())) + "\n")
+	}
+	if item.Source != "" {
+		msg.WriteString(p.Sprintf("• Source: %s", escapeMarkdown(item.Source)) + "\n")
+	}
+	msg.WriteString(p.Sprintf("• Revisions: %d", len(item.Revisions)) + "\n")
+	if !item.LastRevisedAt.IsZero() {
+		lastRevisedAt := tz.In(item.LastRevisedAt).Format(p.Sprintf(i18n.LayoutDateYear))
+		msg.WriteString(p.Sprintf("• Last revised: %s", escapeMarkdown(lastRevisedAt)) + "\n")
+	}
+	dueAt := tz.In(item.NextRevisionAt).Format(p.Sprintf(i18n.LayoutDateYearTime))
+	msg.WriteString(p.Sprintf("• Due: %s", escapeMarkdown(dueAt)))
+	return msg.String()
+}
+
+// itemData is the data of the item buttons.
+type itemData struct {
+	itemID uuid.UUID
+	// page is the page of the list the item was opened from.
+	page int
+	// arg is the argument of the endpoint, empty if none.
+	arg string
+}
+
+func parseItemData(data string) (itemData, error) {
+	op := errs.Op("tgbot.handler.parse_item_data")
+
+	parts := strings.SplitN(data, "|", 3)
+	if len(parts) < 2 {
+		return itemData{}, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid item data").
+			WithContext("data", data)
+	}
+	itemID, err := uuid.FromString(parts[0])
+	if err != nil {
+		return itemData{}, errs.
+			NewIncorrectInputError(op, err, "invalid revise item id").
+			WithContext("data", data)
+	}
+	page, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return itemData{}, errs.
+			NewIncorrectInputError(op, err, "invalid item list page").
+			WithContext("data", data)
+	}
+
+	parsed := itemData{itemID: itemID, page: page}
+	if len(parts) == 3 {
+		parsed.arg = parts[2]
+	}
+	return parsed, nil
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// clearDescription is the value clearing the description of the revise item.
+const clearDescription = "-"
+
+// The steps of the edit conversation are the edited fields.
+const (
+	itemFieldName        conversation.Step = "name"
+	itemFieldDescription conversation.Step = "description"
+	itemFieldTags        conversation.Step = "tags"
+)
+
+// The values of the edit conversation.
+const (
+	editItemKey = "item"
+	editPageKey = "page"
+)
+
+// ItemRename asks for the new name of the revise item.
+func (h *Handler) ItemRename(c tb.Context) error {
+	return h.startItemEdit(c, errs.Op("tgbot.handler.item_rename"), itemFieldName)
+}
+
+// ItemDescribe asks for the new description of the revise item.
+func (h *Handler) ItemDescribe(c tb.Context) error {
+	return h.startItemEdit(c, errs.Op("tgbot.handler.item_describe"), itemFieldDescription)
+}
+
+// ItemTag asks for the tags to add to the revise item.
+func (h *Handler) ItemTag(c tb.Context) error {
+	return h.startItemEdit(c, errs.Op("tgbot.handler.item_tag"), itemFieldTags)
+}
+
+func (h *Handler) startItemEdit(c tb.Context, op errs.Op, field conversation.Step) error {
+	data, err := parseItemData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse item data")
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     data.itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	conv := conversation.New(c.Chat().ID, conversation.FlowEditItem, field, time.Now())
+	conv.Set(editItemKey, data.itemID.String())
+	conv.Set(editPageKey, strconv.Itoa(data.page))
+	if err := h.conversations.Save(context.TODO(), conv); err != nil {
+		return errs.WithOp(op, err, "failed to save conversation")
+	}
+
+	p := h.printer(c)
+	name := escapeMarkdown(item.Name)
+	var prompt string
+	switch field {
+	case itemFieldName:
+		prompt = p.Sprintf("✏️ Send the new name of *%s*", name)
+	case itemFieldDescription:
+		prompt = p.Sprintf("📝 Send the new description of *%s*, or \\- to clear it", name)
+	case itemFieldTags:
+		prompt = p.Sprintf("🏷 Send the tags to add to *%s*, separated by commas", name)
+	}
+
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Send(
+		prompt+"\n\n"+p.Sprintf("_Send /cancel to keep it as it is_"),
+		&tb.ReplyMarkup{ForceReply: true},
+		tb.ModeMarkdownV2,
+	)
+}
+
+// editItem applies the text of the user to the field of the edit conversation.
+func (h *Handler) editItem(c tb.Context, conv conversation.Conversation) error {
+	op := errs.Op("tgbot.handler.edit_item")
+
+	itemID, err := uuid.FromString(conv.Value(editItemKey))
+	if err != nil {
+		return errs.
+			NewUnknownError(op, err, "invalid revise item id of the conversation").
+			WithContext("chat_id", conv.ChatID())
+	}
+	// the page only leads back to the list, the first one will do
+	page, err := strconv.Atoi(conv.Value(editPageKey))
+	if err != nil {
+		page = 1
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	text := strings.TrimSpace(c.Text())
+	switch conv.Step() {
+	case itemFieldName:
+		err = h.app.ReviseItem.Command.ChangeName.Handle(context.TODO(), command.ChangeName{
+			ID:     itemID,
+			UserID: userID,
+			Name:   text,
+		})
+	case itemFieldDescription:
+		if text == clearDescription {
+			text = ""
+		}
+		err = h.app.ReviseItem.Command.ChangeDescription.Handle(context.TODO(), command.ChangeDescription{
+			ID:          itemID,
+			UserID:      userID,
+			Description: text,
+		})
+	case itemFieldTags:
+		err = h.app.ReviseItem.Command.AddTags.Handle(context.TODO(), command.AddTags{
+			ID:     itemID,
+			UserID: userID,
+			Tags:   valueobject.NewTags(strings.Split(text, ",")...),
+		})
+	}
+	if err != nil {
+		return errs.WithOp(op, err, "failed to change revise item")
+	}
+	if err := h.conversations.Delete(context.TODO(), conv.ChatID()); err != nil {
+		return errs.WithOp(op, err, "failed to end conversation")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	p := h.printer(c)
+	view, markup := itemView(p, h.timezone(c), item, page)
+	return c.Reply(p.Sprintf("✅ *Revise Item Updated*")+"\n\n"+view, markup, tb.ModeMarkdownV2)
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+)
+
+func TestItemDetails(t *testing.T) {
+	t.Parallel()
+
+	p := i18n.Printer(valueobject.SupportedLanguages[0])
+	tz, err := valueobject.ParseTimezone("Asia/Almaty")
+	require.NoError(t, err)
+	item := query.ReviseItem{
+		Name: "Go",
+		// the evening of the day before in UTC
+		LastRevisedAt:  time.Date(2026, 10, 16, 20, 30, 0, 0, time.UTC),
+		NextRevisionAt: time.Date(2026, 10, 19, 20, 30, 0, 0, time.UTC),
+	}
+
+	t.Run("Expect times in the time zone of the user", func(t *testing.T) {
+		details := itemDetails(p, tz, item)
+		assert.Contains(t, details, "Last revised: Oct 17, 2026")
+		assert.Contains(t, details, "Due: Oct 20, 2026 01:30 \\+05")
+	})
+	t.Run("Expect times in UTC", func(t *testing.T) {
+		details := itemDetails(p, valueobject.UTC(), item)
+		assert.Contains(t, details, "Last revised: Oct 16, 2026")
+		assert.Contains(t, details, "Due: Oct 19, 2026 20:30 UTC")
+	})
+}
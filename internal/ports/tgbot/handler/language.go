@@ -0,0 +1,76 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	"golang.org/x/text/language"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Language shows the language of the bot, or changes it if a new one is provided.
+//
+//	/language ru
+func (h *Handler) Language(c tb.Context) error {
+	op := errs.Op("handler.language")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	code := strings.TrimSpace(c.Message().Payload)
+	if code != "" {
+		tag, err := valueobject.ParseLanguage(code)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse language")
+		}
+
+		err = h.app.User.Commands.ChangeSettings.Handle(
+			context.TODO(),
+			command.ChangeSettings{ChatID: chatID, Language: &tag},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to change language")
+		}
+		// the reply is already in the new language
+		c.Set(i18n.ContextKey, i18n.Printer(tag))
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	tag, err := language.Parse(queryUser.Settings.Language)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse user language")
+	}
+
+	languages := make([]string, 0, len(valueobject.SupportedLanguages))
+	for _, supported := range valueobject.SupportedLanguages {
+		languages = append(languages, supported.String()+" \\- "+escapeMarkdown(i18n.Name(supported)))
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if code != "" {
+		msg.WriteString(p.Sprintf("✅ *Language Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("🌐 *Language*") + "\n\n")
+	}
+	msg.WriteString(p.Sprintf("• Language: %s", escapeMarkdown(i18n.Name(valueobject.MatchLanguage(tag)))) + "\n\n")
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/language ru\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• Languages: %s", strings.Join(languages, ", ")) + "\n")
+	msg.WriteString(p.Sprintf("• Reminders are sent in the same language"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
@@ -4,46 +4,60 @@ import (
 	"context"
 	"strings"
 
+	"golang.org/x/text/language"
 	tb "gopkg.in/telebot.v4"
 
 	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
 	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
 	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
 func (h *Handler) RegisterUser(c tb.Context) error {
+	p := h.printer(c)
+
 	confirmMsg := strings.Builder{}
-	confirmMsg.WriteString("🔐 *Registration Confirmation*\n\n")
-	confirmMsg.WriteString("*Data We Store:*\n")
-	confirmMsg.WriteString("• Your Telegram Chat ID\n")
-	confirmMsg.WriteString("• Revision items you create:\n")
-	confirmMsg.WriteString("  \\- Item names\n")
-	confirmMsg.WriteString("  \\- Descriptions\n")
-	confirmMsg.WriteString("  \\- Custom tags\n")
-	confirmMsg.WriteString("  \\- Creation dates\n")
-	confirmMsg.WriteString("  \\- Revision schedules\n\n")
+	confirmMsg.WriteString(p.Sprintf("🔐 *Registration Confirmation*") + "\n\n")
+	confirmMsg.WriteString(p.Sprintf("*Data We Store:*") + "\n")
+	confirmMsg.WriteString(p.Sprintf("• Your Telegram Chat ID") + "\n")
+	confirmMsg.WriteString(p.Sprintf("• Revision items you create:") + "\n")
+	confirmMsg.WriteString("  \\- " + p.Sprintf("Item names") + "\n")
+	confirmMsg.WriteString("  \\- " + p.Sprintf("Descriptions") + "\n")
+	confirmMsg.WriteString("  \\- " + p.Sprintf("Custom tags") + "\n")
+	confirmMsg.WriteString("  \\- " + p.Sprintf("Creation dates") + "\n")
+	confirmMsg.WriteString("  \\- " + p.Sprintf("Revision schedules") + "\n\n")
+
+	confirm := button.RegistrationConfirmI
+	confirm.Text = p.Sprintf(confirm.Text)
 
 	return c.Send(
 		confirmMsg.String(),
 		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
-		&tb.ReplyMarkup{InlineKeyboard: [][]tb.InlineButton{{button.RegistrationConfirmI}}},
+		&tb.ReplyMarkup{InlineKeyboard: [][]tb.InlineButton{{confirm}}},
 	)
 }
 
 func (h *Handler) RegisterUserConfirmed(c tb.Context) error {
 	op := errs.Op("tgbot.handler.register_user_confirmed")
 
+	// the user starts with the language of the telegram client, /language changes it later
+	lang := valueobject.MatchLanguage(clientLanguage(c))
+	settings := user.DefaultSettings()
+	settings.Language = lang
+
 	err := h.app.User.Commands.RegisterUser.Handle(
 		context.TODO(),
-		command.RegisterUser{ChatID: user.TelegramID(c.Chat().ID)},
+		command.RegisterUser{ChatID: user.TelegramID(c.Chat().ID), Settings: &settings},
 	)
 	if err != nil {
 		return errs.WithOp(op, err, "failed to register user")
 	}
 
+	p := i18n.Printer(lang)
 	err = c.Edit(
-		"✅ *Registration Confirmed*",
+		p.Sprintf("✅ *Registration Confirmed*"),
 		&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
 	)
 	if err != nil {
@@ -51,11 +65,24 @@ func (h *Handler) RegisterUserConfirmed(c tb.Context) error {
 	}
 
 	msg := strings.Builder{}
-	msg.WriteString("🎉 *Welcome\\!* Your registration is complete\\.\n\n")
-	msg.WriteString("*What you can do now:*\n")
-	msg.WriteString("• Use /help to see available commands\n")
-	msg.WriteString("• Set up your preferences with /settings\n")
-	msg.WriteString("• Start exploring with /menu\n\n")
+	msg.WriteString(p.Sprintf("🎉 *Welcome\\!* Your registration is complete\\.") + "\n\n")
+	msg.WriteString(p.Sprintf("*What you can do now:*") + "\n")
+	msg.WriteString(p.Sprintf("• Use /help to see available commands") + "\n")
+	msg.WriteString(p.Sprintf("• Set up your preferences with /settings") + "\n")
+	msg.WriteString(p.Sprintf("• Change the language with /language") + "\n")
+	msg.WriteString(p.Sprintf("• Start exploring with /menu") + "\n\n")
 
 	return c.Send(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
 }
+
+// clientLanguage returns the language of the telegram client of the sender, undefined if it is unknown.
+func clientLanguage(c tb.Context) language.Tag {
+	if c.Sender() == nil {
+		return language.Und
+	}
+	tag, err := language.Parse(c.Sender().LanguageCode)
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
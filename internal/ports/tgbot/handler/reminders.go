@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Reminders shows the reminder slots and quiet hours of the user, or changes the slots if new ones are provided.
+//
+//	/reminders 07:30 21:00@workdays
+//	/reminders off
+func (h *Handler) Reminders(c tb.Context) error {
+	op := errs.Op("handler.reminders")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	args := strings.Fields(c.Message().Payload)
+	if len(args) > 0 {
+		slots, err := parseReminderSlotsArgs(args)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse reminder slots")
+		}
+
+		err = h.app.User.Commands.ChangeSettings.Handle(
+			context.TODO(),
+			command.ChangeSettings{ChatID: chatID, ReminderSlots: &slots},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to change reminder slots")
+		}
+	}
+
+	return h.replyReminders(c, len(args) > 0)
+}
+
+// QuietHours shows the reminder slots and quiet hours of the user, or changes the quiet hours if provided.
+//
+//	/quiet 22:00-07:00
+//	/quiet off
+func (h *Handler) QuietHours(c tb.Context) error {
+	op := errs.Op("handler.quiet_hours")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	payload := strings.TrimSpace(c.Message().Payload)
+	if payload != "" {
+		quietHours, err := user.ParseQuietHours(payload)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse quiet hours")
+		}
+
+		err = h.app.User.Commands.ChangeSettings.Handle(
+			context.TODO(),
+			command.ChangeSettings{ChatID: chatID, QuietHours: &quietHours},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to change quiet hours")
+		}
+	}
+
+	return h.replyReminders(c, payload != "")
+}
+
+func (h *Handler) replyReminders(c tb.Context, updated bool) error {
+	op := errs.Op("handler.reply_reminders")
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: user.TelegramID(c.Chat().ID)},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if updated {
+		msg.WriteString(p.Sprintf("✅ *Reminders Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("⏰ *Reminders*") + "\n\n")
+	}
+	msg.WriteString(p.Sprintf("• Reminders: %s", escapeMarkdown(formatReminderSlots(p, queryUser.Settings.ReminderSlots))) + "\n")
+	msg.WriteString(p.Sprintf("• Quiet hours: %s", escapeMarkdown(formatQuietHours(p, queryUser.Settings.QuietHours))) + "\n")
+	msg.WriteString(p.Sprintf("• Timezone: %s", escapeMarkdown(queryUser.Settings.Timezone)) + "\n\n")
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/reminders 07:30 21:00@workdays\n")
+	msg.WriteString("/reminders off\n")
+	msg.WriteString("/quiet 22:00\\-07:00\n")
+	msg.WriteString("/quiet off\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• Up to %d reminders per day", user.MaxReminderSlots) + "\n")
+	msg.WriteString(p.Sprintf("• Weekdays: daily, workdays, weekends or a list like mon,wed,fri") + "\n")
+	msg.WriteString(p.Sprintf("• No reminders are sent during the quiet hours"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
+
+func parseReminderSlotsArgs(args []string) ([]user.ReminderSlot, error) {
+	op := errs.Op("handler.parse_reminder_slots_args")
+	if len(args) == 1 && strings.EqualFold(args[0], "off") {
+		return []user.ReminderSlot{}, nil
+	}
+
+	slots := make([]user.ReminderSlot, 0, len(args))
+	for _, arg := range args {
+		slot, err := user.ParseReminderSlot(arg)
+		if err != nil {
+			return nil, errs.WithOp(op, err, "failed to parse reminder slot")
+		}
+		slots = append(slots, slot)
+	}
+	return slots, nil
+}
+
+func formatReminderSlots(p *message.Printer, slots []query.ReminderSlot) string {
+	if len(slots) == 0 {
+		return p.Sprintf("off")
+	}
+
+	formatted := make([]string, 0, len(slots))
+	for _, slot := range slots {
+		s := fmt.Sprintf("%02d:%02d", slot.Hour, slot.Minute)
+		if slot.Weekdays != user.EveryDay.String() {
+			s += " " + slot.Weekdays
+		}
+		formatted = append(formatted, s)
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// formatQuietHours localises the "off" of the quiet hours that are not set.
+func formatQuietHours(p *message.Printer, quietHours string) string {
+	if quietHours == (user.QuietHours{}).String() {
+		return p.Sprintf("off")
+	}
+	return quietHours
+}
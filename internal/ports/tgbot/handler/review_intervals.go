@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+	"strings"
+
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// ReviewIntervals shows the review intervals of the user, or changes them if new ones are provided.
+//
+//	/intervals 1d 3d 1w 2M
+func (h *Handler) ReviewIntervals(c tb.Context) error {
+	op := errs.Op("handler.review_intervals")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	payload := strings.TrimSpace(c.Message().Payload)
+	if payload != "" {
+		intervals, err := valueobject.ParseReviewInterval(payload)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse review intervals")
+		}
+
+		err = h.app.User.Commands.ChangeSettings.Handle(
+			context.TODO(),
+			command.ChangeSettings{ChatID: chatID, ReviewIntervals: &intervals},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to change review intervals")
+		}
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if payload != "" {
+		msg.WriteString(p.Sprintf("✅ *Review Intervals Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("🗓 *Review Intervals*") + "\n\n")
+	}
+	msg.WriteString("`" + queryUser.Settings.ReviewIntervals + "`\n\n")
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString("/intervals 1d 3d 1w 2M\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• Units: m \\- minutes, h \\- hours, d \\- days, w \\- weeks, M \\- months, y \\- years") + "\n")
+	msg.WriteString(p.Sprintf("• Missing intervals are taken from the default ones"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
@@ -17,33 +17,21 @@ import (
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 )
 
+// CreateItem creates a revise item. Without arguments it starts the create wizard asking for the values one by one,
+// with quoted arguments it creates the item right away:
+//
+//	/revise_create "Go Maps" "Understanding Go maps" "go,data-structures"
 func (h *Handler) CreateItem(c tb.Context) error {
 	op := errs.Op("handler.create_item")
 
 	fullText := c.Message().Text
 	commandEnd := strings.Index(fullText, "/revise_create") + len("/revise_create")
-	if commandEnd >= len(fullText) {
-		return c.Reply(
-			"⚠️ *Usage:*\n"+
-				"/revise\\_create \"name\" \\[\"description\"\\] \\[\"tags\"\\]\n\n"+
-				"*Example:*\n"+
-				"/revise\\_create \"Go Maps\" \"Understanding Go maps\" \"go,data\\-structures\"\n\n"+
-				"Note:\n"+
-				"• *Name* is required\n"+
-				"• *Description* and *tags* are optional\n"+
-				"• Use quotes for values with spaces\n"+
-				"• Tags should be comma\\-separated",
-			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
-		)
+	var args []string
+	if commandEnd < len(fullText) {
+		args = parseQuotedArgs(fullText[commandEnd:])
 	}
-
-	// Parse quoted arguments
-	args := parseQuotedArgs(fullText[commandEnd:])
 	if len(args) == 0 {
-		return c.Reply(
-			"❌ *Name is required*",
-			&tb.SendOptions{ParseMode: tb.ModeMarkdownV2},
-		)
+		return h.startCreateWizard(c)
 	}
 
 	name := args[0]
@@ -55,27 +43,34 @@ func (h *Handler) CreateItem(c tb.Context) error {
 	}
 
 	if len(args) > 2 {
-		tagList := strings.Split(args[2], ",")
-		tags = make([]string, 0, len(tagList))
-		for _, tag := range tagList {
-			trimmed := strings.TrimSpace(tag)
-			if trimmed != "" {
-				tags = append(tags, trimmed)
-			}
-		}
+		tags = splitTags(args[2])
 	}
 
+	msg, err := h.createReviseItem(c, name, description, "", tags)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create item")
+	}
+	return c.Reply(msg, &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
+
+// createReviseItem creates the revise item of the user and returns the message announcing it.
+// The name may be empty if the source is set, the item is named after the source then.
+func (h *Handler) createReviseItem(c tb.Context, name, description, source string, tags []string) (string, error) {
+	op := errs.Op("handler.create_revise_item")
+
+	p := h.printer(c)
+
 	queryUser, err := h.app.User.Queries.GetUser.Handle(
 		context.TODO(),
 		query.GetUser{ChatID: user.TelegramID(c.Chat().ID)},
 	)
 	if err != nil {
-		return errs.WithOp(op, err, "failed to get user")
+		return "", errs.WithOp(op, err, "failed to get user")
 	}
 
 	userID, err := uuid.FromString(queryUser.ID)
 	if err != nil {
-		return errs.WithOp(op, err, "failed to parse user ID")
+		return "", errs.WithOp(op, err, "failed to parse user ID")
 	}
 
 	reviseItemId := reviseitem.NewReviseItemID()
@@ -87,10 +82,11 @@ func (h *Handler) CreateItem(c tb.Context) error {
 			Name:        name,
 			Description: description,
 			Tags:        valueobject.NewTags(tags...),
+			Source:      source,
 		},
 	)
 	if err != nil {
-		return errs.WithOp(op, err, "failed to create item")
+		return "", errs.WithOp(op, err, "failed to create item")
 	}
 
 	revisionItem, err := h.app.ReviseItem.Query.GetReviseItem.Handle(
@@ -98,23 +94,39 @@ func (h *Handler) CreateItem(c tb.Context) error {
 		reviseitemquery.GetReviseItem{ID: reviseItemId, UserID: userID},
 	)
 	if err != nil {
-		return errs.WithOp(op, err, "failed to get revision item")
+		return "", errs.WithOp(op, err, "failed to get revision item")
 	}
 
 	msg := strings.Builder{}
-	msg.WriteString("✅ *Revision Item Created*\n\n")
-	msg.WriteString("*Name:* " + escapeMarkdown(revisionItem.Name) + "\n")
+	msg.WriteString(p.Sprintf("✅ *Revision Item Created*") + "\n\n")
+	msg.WriteString(p.Sprintf("*Name:* %s", escapeMarkdown(revisionItem.Name)) + "\n")
 	if description != "" {
-		msg.WriteString("*Description:* " + escapeMarkdown(revisionItem.Description) + "\n")
+		msg.WriteString(p.Sprintf("*Description:* %s", escapeMarkdown(revisionItem.Description)) + "\n")
 	}
 	if len(tags) > 0 {
 		msg.WriteString(
-			"*Tags:* " + escapeMarkdown(strings.Join(revisionItem.Tags.StringArray(), ", ")) + "\n",
+			p.Sprintf("*Tags:* %s", escapeMarkdown(strings.Join(revisionItem.Tags.StringArray(), ", "))) + "\n",
 		)
 	}
-	msg.WriteString("\nUse /list to see all your items")
+	if revisionItem.Source != "" {
+		msg.WriteString(p.Sprintf("*Source:* %s", escapeMarkdown(revisionItem.Source)) + "\n")
+	}
+	msg.WriteString("\n" + p.Sprintf("Use /list to see all your items"))
 
-	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+	return msg.String(), nil
+}
+
+// splitTags splits the comma separated tags, dropping the empty ones.
+func splitTags(s string) []string {
+	tagList := strings.Split(s, ",")
+	tags := make([]string, 0, len(tagList))
+	for _, tag := range tagList {
+		trimmed := strings.TrimSpace(tag)
+		if trimmed != "" {
+			tags = append(tags, trimmed)
+		}
+	}
+	return tags
 }
 
 func escapeMarkdown(text string) string {
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// PostponeItem handles the postpone buttons attached to the due revise items.
+func (h *Handler) PostponeItem(c tb.Context) error {
+	op := errs.Op("tgbot.handler.postpone_item")
+
+	itemID, duration, err := parsePostponeData(c.Callback().Data)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse postpone data")
+	}
+
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	nextRevisionAt, err := h.app.ReviseItem.Command.Postpone.Handle(context.TODO(), command.Postpone{
+		ID:       itemID,
+		UserID:   userID,
+		Duration: duration,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to postpone revise item")
+	}
+
+	p := h.printer(c)
+	msg := p.Sprintf("Postponed until %s", h.timezone(c).In(nextRevisionAt).Format(p.Sprintf(i18n.LayoutDateTime)))
+	if err := c.Respond(&tb.CallbackResponse{Text: msg}); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+
+	return c.Edit(c.Message().Text + "\n\n⏰ " + msg)
+}
+
+func parsePostponeData(data string) (uuid.UUID, time.Duration, error) {
+	op := errs.Op("tgbot.handler.parse_postpone_data")
+
+	rawID, rawDuration, ok := strings.Cut(data, "|")
+	if !ok {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid postpone data").
+			WithContext("data", data)
+	}
+	itemID, err := uuid.FromString(rawID)
+	if err != nil {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, err, "invalid revise item id").
+			WithContext("data", data)
+	}
+	duration, err := time.ParseDuration(rawDuration)
+	if err != nil {
+		return uuid.Nil, 0, errs.
+			NewIncorrectInputError(op, err, "invalid postpone duration").
+			WithContext("data", data)
+	}
+	return itemID, duration, nil
+}
@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+const (
+	// searchPageSize is the number of the inline results sent at once, telegram allows up to 50.
+	searchPageSize = 20
+	// searchCacheTime is the number of seconds telegram caches the inline results for,
+	// the items change often so it is kept short.
+	searchCacheTime = 10
+	// itemStartPrefix prefixes the start parameter of the links opening a revise item in the bot.
+	itemStartPrefix = "item_"
+	// registerStartParameter is the start parameter of the button offering the registration.
+	registerStartParameter = "register"
+)
+
+// SearchItems answers the inline queries with the revise items of the user whose name,
+// description or tags contain the query, the results share the item into the chat.
+//
+//	@bot physics
+func (h *Handler) SearchItems(c tb.Context) error {
+	op := errs.Op("tgbot.handler.search_items")
+
+	p := h.printer(c)
+	userID, err := h.userID(c)
+	if err != nil {
+		if !errs.IsErrorType(err, errs.ErrorTypeNotFound) {
+			return errs.WithOp(op, err, "failed to get user id")
+		}
+		// the unregistered users get a button leading to the registration instead of the results
+		return c.Answer(&tb.QueryResponse{
+			Results:    tb.Results{},
+			CacheTime:  searchCacheTime,
+			IsPersonal: true,
+			Button:     &tb.QueryResponseButton{Text: p.Sprintf("Register to search your items"), Start: registerStartParameter},
+		})
+	}
+
+	page := 1
+	if offset := c.Query().Offset; offset != "" {
+		page, err = strconv.Atoi(offset)
+		if err != nil || page < 1 {
+			return errs.
+				NewIncorrectInputError(op, errs.ErrInvalidInput, "invalid inline query offset").
+				WithContext("offset", offset)
+		}
+	}
+
+	items, meta, err := h.app.ReviseItem.Query.SearchUserReviseItems.Handle(
+		context.TODO(),
+		query.SearchUserReviseItems{
+			UserID:     userID,
+			Text:       c.Query().Text,
+			Pagination: query.Pagination{Page: page, PageSize: searchPageSize},
+		},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to search revise items")
+	}
+
+	username := botUsername(c)
+	results := make(tb.Results, 0, len(items))
+	for _, item := range items {
+		results = append(results, searchResult(p, item, username))
+	}
+
+	var nextOffset string
+	if page < meta.LastPage {
+		nextOffset = strconv.Itoa(page + 1)
+	}
+	return c.Answer(&tb.QueryResponse{
+		Results:    results,
+		CacheTime:  searchCacheTime,
+		IsPersonal: true,
+		NextOffset: nextOffset,
+	})
+}
+
+// openSharedItem shows the revise item of the start link of a shared item,
+// only its owner can open it.
+func (h *Handler) openSharedItem(c tb.Context, payload string) error {
+	op := errs.Op("tgbot.handler.open_shared_item")
+
+	itemID, err := uuid.FromString(strings.TrimPrefix(payload, itemStartPrefix))
+	if err != nil {
+		return errs.
+			NewIncorrectInputError(op, err, "invalid revise item id").
+			WithContext("payload", payload)
+	}
+	userID, err := h.userID(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	item, err := h.app.ReviseItem.Query.GetReviseItem.Handle(context.TODO(), query.GetReviseItem{
+		ID:     itemID,
+		UserID: userID,
+	})
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get revise item")
+	}
+
+	text, markup := itemView(h.printer(c), h.timezone(c), item, 1)
+	return c.Send(text, markup, tb.ModeMarkdownV2)
+}
+
+// searchResult renders the revise item as an inline result, the sent message has a button opening
+// the item in the bot if the username of the bot is known.
+func searchResult(p *message.Printer, item query.ReviseItem, botUsername string) *tb.ArticleResult {
+	result := &tb.ArticleResult{
+		ResultBase: tb.ResultBase{
+			ID:        item.ID.String(),
+			ParseMode: tb.ModeMarkdownV2,
+		},
+		Title:       item.Name,
+		Description: resultDescription(item),
+		Text:        sharedItem(p, item),
+	}
+	if botUsername != "" {
+		link := fmt.Sprintf("https://t.me/%s?start=%s%s", botUsername, itemStartPrefix, item.ID)
+		result.ReplyMarkup = &tb.ReplyMarkup{InlineKeyboard: [][]tb.InlineButton{
+			{{Text: p.Sprintf("📖 Open in the bot"), URL: link}},
+		}}
+	}
+	return result
+}
+
+// sharedItem renders the revise item shared into a chat as MarkdownV2,
+// unlike itemDetails the schedule of the owner is left out.
+func sharedItem(p *message.Printer, item query.ReviseItem) string {
+	msg := strings.Builder{}
+	msg.WriteString(fmt.Sprintf("📖 *%s*", escapeMarkdown(item.Name)))
+	if item.Description != "" {
+		msg.WriteString(fmt.Sprintf("\n\n%s", escapeMarkdown(item.Description)))
+	}
+	if !item.Tags.IsEmpty() || item.Source != "" {
+		msg.WriteString("\n")
+	}
+	if !item.Tags.IsEmpty() {
+		msg.WriteString("\n" + p.Sprintf("• Tags: %s", escapeMarkdown(item.Tags.String())))
+	}
+	if item.Source != "" {
+		msg.WriteString("\n" + p.Sprintf("• Source: %s", escapeMarkdown(item.Source)))
+	}
+	return msg.String()
+}
+
+// resultDescription returns the line shown under the name of the item in the inline results,
+// the first line of the description or the tags.
+func resultDescription(item query.ReviseItem) string {
+	if item.Description != "" {
+		firstLine, _, _ := strings.Cut(item.Description, "\n")
+		return firstLine
+	}
+	return item.Tags.String()
+}
+
+// botUsername returns the username of the bot, empty if it is unknown, e.g. in the offline mode.
+func botUsername(c tb.Context) string {
+	bot, ok := c.Bot().(*tb.Bot)
+	if !ok || bot.Me == nil {
+		return ""
+	}
+	return bot.Me.Username
+}
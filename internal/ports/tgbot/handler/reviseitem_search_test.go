@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+)
+
+func TestSearchResult(t *testing.T) {
+	t.Parallel()
+
+	p := i18n.Printer(valueobject.SupportedLanguages[0])
+	item := query.ReviseItem{
+		ID:          uuid.FromStringOrNil("d7accc08-981f-4aa7-8477-b1840b9a2611"),
+		Name:        "Go 1.23",
+		Description: "Range over functions\nand iterators",
+		Tags:        valueobject.NewTags("go", "release"),
+		Source:      "https://go.dev/blog/go1.23",
+	}
+
+	t.Run("Expect item without schedule", func(t *testing.T) {
+		result := searchResult(p, item, "")
+		assert.Equal(t, item.ID.String(), result.ID)
+		assert.Equal(t, "Go 1.23", result.Title)
+		assert.Equal(t, "Range over functions", result.Description)
+		assert.Equal(t, tb.ModeMarkdownV2, result.ParseMode)
+		assert.Equal(
+			t,
+			"📖 *Go 1\\.23*\n\nRange over functions\nand iterators\n\n"+
+				"• Tags: go, release\n• Source: https://go\\.dev/blog/go1\\.23",
+			result.Text,
+		)
+		assert.Nil(t, result.ReplyMarkup, "the item can not be opened without the bot username")
+	})
+
+	t.Run("Expect button opening the item in the bot", func(t *testing.T) {
+		result := searchResult(p, item, "revise_bot")
+		require.NotNil(t, result.ReplyMarkup)
+		assert.Equal(
+			t,
+			"https://t.me/revise_bot?start=item_d7accc08-981f-4aa7-8477-b1840b9a2611",
+			result.ReplyMarkup.InlineKeyboard[0][0].URL,
+		)
+	})
+
+	t.Run("Expect tags under the name of item without description", func(t *testing.T) {
+		result := searchResult(p, query.ReviseItem{Name: "Notes", Tags: valueobject.NewTags("go")}, "")
+		assert.Equal(t, "go", result.Description)
+		assert.Equal(t, "📖 *Notes*\n\n• Tags: go", result.Text)
+	})
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// privateChannelPrefix prefixes the ids of the channels in the bot api, the message links use the ids without it.
+const privateChannelPrefix = "-100"
+
+// Forward creates a revise item from the forwarded message, the source is the link to the original message
+// or the first link in the message if the original one can not be linked.
+func (h *Handler) Forward(c tb.Context) error {
+	op := errs.Op("handler.forward")
+
+	name, description, source := forwardedItem(h.printer(c), c.Message())
+	msg, err := h.createReviseItem(c, name, description, source, nil)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create item from forwarded message")
+	}
+	return c.Reply(msg, tb.ModeMarkdownV2)
+}
+
+// shareURL creates a revise item from the shared link, it is named after the title of the page.
+func (h *Handler) shareURL(c tb.Context, link string) error {
+	op := errs.Op("handler.share_url")
+
+	msg, err := h.createReviseItem(c, "", "", link, nil)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to create item from link")
+	}
+	return c.Reply(msg, tb.ModeMarkdownV2)
+}
+
+// forwardedItem returns the name, description and source of the revise item of the forwarded message.
+// The name is empty if the message is a bare link, the item is named after the linked page then.
+func forwardedItem(p *message.Printer, m *tb.Message) (name, description, source string) {
+	text := strings.TrimSpace(m.Text)
+	if text == "" {
+		text = strings.TrimSpace(m.Caption)
+	}
+	if link, ok := messageURL(m); ok {
+		return "", "", link
+	}
+
+	source = messageLink(m.Origin)
+	if source == "" {
+		source = firstLink(m)
+	}
+
+	if text == "" {
+		return reviseitem.TruncateName(p.Sprintf("Message from %s", originName(m.Origin))), "", source
+	}
+	firstLine, _, more := strings.Cut(text, "\n")
+	name = reviseitem.TruncateName(firstLine)
+	if more || name != text {
+		description = reviseitem.TruncateDescription(text)
+	}
+	return name, description, source
+}
+
+// messageLink returns the link to the original message, empty if it can not be linked,
+// only the messages of the channels can.
+func messageLink(origin *tb.MessageOrigin) string {
+	if origin == nil || origin.Chat == nil || origin.MessageID == 0 {
+		return ""
+	}
+	messageID := strconv.Itoa(origin.MessageID)
+	if origin.Chat.Username != "" {
+		return "https://t.me/" + origin.Chat.Username + "/" + messageID
+	}
+	chatID := strings.TrimPrefix(strconv.FormatInt(origin.Chat.ID, 10), privateChannelPrefix)
+	return "https://t.me/c/" + chatID + "/" + messageID
+}
+
+// firstLink returns the first http(s) link of the message text, empty if there is none.
+func firstLink(m *tb.Message) string {
+	entities := m.Entities
+	if len(entities) == 0 {
+		entities = m.CaptionEntities
+	}
+	for _, e := range entities {
+		if link, ok := entityURL(m, e); ok {
+			return link
+		}
+	}
+	return ""
+}
+
+// originName returns the name of the author of the original message.
+func originName(origin *tb.MessageOrigin) string {
+	switch {
+	case origin == nil:
+		return "Telegram"
+	case origin.Chat != nil:
+		return origin.Chat.Title
+	case origin.SenderChat != nil:
+		return origin.SenderChat.Title
+	case origin.Sender != nil:
+		return strings.TrimSpace(origin.Sender.FirstName + " " + origin.Sender.LastName)
+	default:
+		return origin.SenderUsername
+	}
+}
+
+// messageURL reports whether the message is a bare link and returns it. The message is a link if its text
+// is a http(s) link or if Telegram marks its whole text as a link, the text like "file.txt" or "v1.2" is not.
+func messageURL(m *tb.Message) (string, bool) {
+	text, entities := m.Text, m.Entities
+	if text == "" {
+		text, entities = m.Caption, m.CaptionEntities
+	}
+	if link, ok := sharedURL(text); ok {
+		return link, true
+	}
+	text = strings.TrimSpace(text)
+	for _, e := range entities {
+		if text != "" && strings.TrimSpace(m.EntityText(e)) == text {
+			return entityURL(m, e)
+		}
+	}
+	return "", false
+}
+
+// entityURL returns the http(s) link of the url and text link entities,
+// Telegram marks the links without a scheme as well, they get https.
+func entityURL(m *tb.Message, e tb.MessageEntity) (string, bool) {
+	switch e.Type {
+	case tb.EntityURL:
+		link := strings.TrimSpace(m.EntityText(e))
+		if !strings.Contains(link, "://") {
+			link = "https://" + link
+		}
+		return sharedURL(link)
+	case tb.EntityTextLink:
+		return sharedURL(e.URL)
+	default:
+		return "", false
+	}
+}
+
+// sharedURL reports whether the text is a bare link with the http or https scheme and returns it.
+func sharedURL(text string) (string, bool) {
+	text = strings.TrimSpace(text)
+	if text == "" || strings.ContainsAny(text, " \t\n") {
+		return "", false
+	}
+	u, err := url.Parse(text)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return "", false
+	}
+	if reviseitem.ValidateSource(text) != nil {
+		return "", false
+	}
+	return text, true
+}
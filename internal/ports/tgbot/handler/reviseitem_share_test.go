@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
+)
+
+func TestSharedURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		text string
+		want string
+		ok   bool
+	}{
+		{text: "https://go.dev/ref/mem", want: "https://go.dev/ref/mem", ok: true},
+		{text: "  http://go.dev/blog  ", want: "http://go.dev/blog", ok: true},
+		{text: "go.dev/blog", ok: false},
+		{text: "read https://go.dev/ref/mem", ok: false},
+		{text: "hello", ok: false},
+		{text: "Thanks.", ok: false},
+		{text: "ok.", ok: false},
+		{text: "e.g.", ok: false},
+		{text: "v1.2", ok: false},
+		{text: "file.txt", ok: false},
+		{text: "169.254.169.254", ok: false},
+		{text: "ftp://example.com/file", ok: false},
+		{text: "https://", ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, ok := sharedURL(tt.text)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMessageURL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		message *tb.Message
+		want    string
+		ok      bool
+	}{
+		{
+			name:    "link with scheme",
+			message: &tb.Message{Text: "https://go.dev/ref/mem"},
+			want:    "https://go.dev/ref/mem", ok: true,
+		},
+		{
+			name: "link without scheme marked by Telegram",
+			message: &tb.Message{
+				Text:     " go.dev/blog ",
+				Entities: tb.Entities{{Type: tb.EntityURL, Offset: 1, Length: 11}},
+			},
+			want: "https://go.dev/blog", ok: true,
+		},
+		{
+			name: "text link spanning the whole text",
+			message: &tb.Message{
+				Text:     "the memory model",
+				Entities: tb.Entities{{Type: tb.EntityTextLink, Offset: 0, Length: 16, URL: "https://go.dev/ref/mem"}},
+			},
+			want: "https://go.dev/ref/mem", ok: true,
+		},
+		{
+			name:    "link in the caption",
+			message: &tb.Message{Caption: "https://go.dev/ref/mem"},
+			want:    "https://go.dev/ref/mem", ok: true,
+		},
+		{
+			name: "link in the text",
+			message: &tb.Message{
+				Text:     "read go.dev/blog",
+				Entities: tb.Entities{{Type: tb.EntityURL, Offset: 5, Length: 11}},
+			},
+		},
+		{name: "text with a dot not marked by Telegram", message: &tb.Message{Text: "file.txt"}},
+		{name: "reply", message: &tb.Message{Text: "Thanks."}},
+		{name: "empty", message: &tb.Message{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := messageURL(tt.message)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestForwardedItem(t *testing.T) {
+	t.Parallel()
+
+	p := i18n.Printer(valueobject.SupportedLanguages[0])
+
+	t.Run("Expect link to the public channel post", func(t *testing.T) {
+		name, description, source := forwardedItem(p, &tb.Message{
+			Text:   "Go 1.23 is released\nRange over functions are here.",
+			Origin: &tb.MessageOrigin{Chat: &tb.Chat{ID: -1001234567890, Username: "golang_news"}, MessageID: 42},
+		})
+		assert.Equal(t, "Go 1.23 is released", name)
+		assert.Equal(t, "Go 1.23 is released\nRange over functions are here.", description)
+		assert.Equal(t, "https://t.me/golang_news/42", source)
+	})
+
+	t.Run("Expect link to the private channel post", func(t *testing.T) {
+		_, _, source := forwardedItem(p, &tb.Message{
+			Text:   "Notes",
+			Origin: &tb.MessageOrigin{Chat: &tb.Chat{ID: -1001234567890}, MessageID: 7},
+		})
+		assert.Equal(t, "https://t.me/c/1234567890/7", source)
+	})
+
+	t.Run("Expect first link of the user message", func(t *testing.T) {
+		name, description, source := forwardedItem(p, &tb.Message{
+			Text:     "Read this https://go.dev/ref/mem",
+			Entities: tb.Entities{{Type: tb.EntityURL, Offset: 10, Length: 22}},
+			Origin:   &tb.MessageOrigin{Sender: &tb.User{FirstName: "Rob"}},
+		})
+		assert.Equal(t, "Read this https://go.dev/ref/mem", name)
+		assert.Empty(t, description)
+		assert.Equal(t, "https://go.dev/ref/mem", source)
+	})
+
+	t.Run("Expect bare link to be named after the page", func(t *testing.T) {
+		name, _, source := forwardedItem(p, &tb.Message{
+			Text:   "https://go.dev/ref/mem",
+			Origin: &tb.MessageOrigin{Sender: &tb.User{FirstName: "Rob"}},
+		})
+		assert.Empty(t, name)
+		assert.Equal(t, "https://go.dev/ref/mem", source)
+	})
+
+	t.Run("Expect message without text to be named after its author", func(t *testing.T) {
+		name, _, source := forwardedItem(p, &tb.Message{
+			Origin: &tb.MessageOrigin{Sender: &tb.User{FirstName: "Rob", LastName: "Pike"}},
+		})
+		assert.Equal(t, "Message from Rob Pike", name)
+		assert.Empty(t, source)
+	})
+}
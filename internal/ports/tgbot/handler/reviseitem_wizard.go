@@ -0,0 +1,343 @@
+package handler
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// The steps of the create wizard, in order.
+const (
+	wizardStepName        conversation.Step = "name"
+	wizardStepDescription conversation.Step = "description"
+	wizardStepTags        conversation.Step = "tags"
+)
+
+// The values collected by the create wizard, the tags are comma separated.
+const (
+	wizardNameKey        = "name"
+	wizardDescriptionKey = "description"
+	wizardTagsKey        = "tags"
+)
+
+const (
+	// wizardTagSuggestions is the number of the tags of the user suggested on the tags step.
+	wizardTagSuggestions = 9
+	// wizardTagsPerRow is the number of the suggested tag buttons in a row.
+	wizardTagsPerRow = 3
+	// wizardMaxTagBytes is the longest tag fitting the 64 bytes of the callback data with the endpoint.
+	wizardMaxTagBytes = 48
+)
+
+// startCreateWizard starts the conversation creating a revise item, it asks for the name first.
+func (h *Handler) startCreateWizard(c tb.Context) error {
+	op := errs.Op("handler.start_create_wizard")
+
+	if _, err := h.userID(c); err != nil {
+		return errs.WithOp(op, err, "failed to get user id")
+	}
+
+	conv := conversation.New(c.Chat().ID, conversation.FlowCreateItem, wizardStepName, time.Now())
+	if err := h.conversations.Save(context.TODO(), conv); err != nil {
+		return errs.WithOp(op, err, "failed to save conversation")
+	}
+
+	text, markup, err := h.wizardPrompt(c, conv)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render wizard")
+	}
+	return c.Reply(text, markup, tb.ModeMarkdownV2)
+}
+
+// createItemStep takes the answer of the user to the current step of the create wizard.
+func (h *Handler) createItemStep(c tb.Context, conv conversation.Conversation) error {
+	op := errs.Op("handler.create_item_step")
+
+	text := strings.TrimSpace(c.Text())
+	switch conv.Step() {
+	case wizardStepName:
+		if err := reviseitem.ValidateName(text); err != nil {
+			return errs.WithOp(op, err, "invalid name")
+		}
+		conv.Set(wizardNameKey, text)
+		conv.MoveTo(wizardStepDescription, time.Now())
+	case wizardStepDescription:
+		if err := reviseitem.ValidateDescription(text); err != nil {
+			return errs.WithOp(op, err, "invalid description")
+		}
+		conv.Set(wizardDescriptionKey, text)
+		conv.MoveTo(wizardStepTags, time.Now())
+	case wizardStepTags:
+		// the typed tags are added to the picked ones and the item is created right away
+		tags := append(wizardTags(conv), splitTags(text)...)
+		msg, err := h.finishCreateWizard(c, conv, tags)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to finish wizard")
+		}
+		return c.Reply(msg, tb.ModeMarkdownV2)
+	}
+
+	if err := h.conversations.Save(context.TODO(), conv); err != nil {
+		return errs.WithOp(op, err, "failed to save conversation")
+	}
+	prompt, markup, err := h.wizardPrompt(c, conv)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render wizard")
+	}
+	return c.Reply(prompt, markup, tb.ModeMarkdownV2)
+}
+
+// WizardSkip skips the description of the revise item.
+func (h *Handler) WizardSkip(c tb.Context) error {
+	op := errs.Op("tgbot.handler.wizard_skip")
+
+	conv, ok, err := h.wizard(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get wizard")
+	}
+	if !ok {
+		return nil
+	}
+	if conv.Step() == wizardStepDescription {
+		conv.Set(wizardDescriptionKey, "")
+		conv.MoveTo(wizardStepTags, time.Now())
+	}
+	return h.refreshWizard(c, conv)
+}
+
+// WizardBack returns to the previous step of the create wizard.
+func (h *Handler) WizardBack(c tb.Context) error {
+	op := errs.Op("tgbot.handler.wizard_back")
+
+	conv, ok, err := h.wizard(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get wizard")
+	}
+	if !ok {
+		return nil
+	}
+	switch conv.Step() {
+	case wizardStepDescription:
+		conv.MoveTo(wizardStepName, time.Now())
+	case wizardStepTags:
+		conv.MoveTo(wizardStepDescription, time.Now())
+	}
+	return h.refreshWizard(c, conv)
+}
+
+// WizardTag picks the suggested tag for the revise item, or drops it if it was picked.
+func (h *Handler) WizardTag(c tb.Context) error {
+	op := errs.Op("tgbot.handler.wizard_tag")
+
+	conv, ok, err := h.wizard(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get wizard")
+	}
+	if !ok {
+		return nil
+	}
+	if conv.Step() == wizardStepTags {
+		tag := c.Callback().Data
+		tags := wizardTags(conv)
+		if i := slices.Index(tags, tag); i >= 0 {
+			tags = slices.Delete(tags, i, i+1)
+		} else {
+			tags = append(tags, tag)
+		}
+		conv.Set(wizardTagsKey, strings.Join(tags, ","))
+		conv.MoveTo(wizardStepTags, time.Now())
+	}
+	return h.refreshWizard(c, conv)
+}
+
+// WizardCreate creates the revise item with the picked tags.
+func (h *Handler) WizardCreate(c tb.Context) error {
+	op := errs.Op("tgbot.handler.wizard_create")
+
+	conv, ok, err := h.wizard(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get wizard")
+	}
+	if !ok {
+		return nil
+	}
+
+	msg, err := h.finishCreateWizard(c, conv, wizardTags(conv))
+	if err != nil {
+		return errs.WithOp(op, err, "failed to finish wizard")
+	}
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(msg, tb.ModeMarkdownV2)
+}
+
+// WizardCancel stops the create wizard.
+func (h *Handler) WizardCancel(c tb.Context) error {
+	op := errs.Op("tgbot.handler.wizard_cancel")
+
+	_, ok, err := h.wizard(c)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get wizard")
+	}
+	if !ok {
+		return nil
+	}
+	if err := h.conversations.Delete(context.TODO(), c.Chat().ID); err != nil {
+		return errs.WithOp(op, err, "failed to end conversation")
+	}
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(h.printer(c).Sprintf("Cancelled"))
+}
+
+// wizard returns the create wizard of the chat for the wizard buttons,
+// false if there is none and the user was told so.
+func (h *Handler) wizard(c tb.Context) (conversation.Conversation, bool, error) {
+	op := errs.Op("tgbot.handler.wizard")
+
+	conv, ok, err := h.conversation(c)
+	if err != nil {
+		return conversation.Conversation{}, false, errs.WithOp(op, err, "failed to get conversation")
+	}
+	if ok && conv.Flow() == conversation.FlowCreateItem {
+		return conv, true, nil
+	}
+
+	notice := h.printer(c).Sprintf("This revise item creation is over, start a new one with /revise_create")
+	if err := c.Respond(&tb.CallbackResponse{Text: notice}); err != nil {
+		return conversation.Conversation{}, false, errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return conversation.Conversation{}, false, nil
+}
+
+// refreshWizard saves the create wizard changed by a button and shows its current step.
+func (h *Handler) refreshWizard(c tb.Context, conv conversation.Conversation) error {
+	op := errs.Op("tgbot.handler.refresh_wizard")
+
+	if err := h.conversations.Save(context.TODO(), conv); err != nil {
+		return errs.WithOp(op, err, "failed to save conversation")
+	}
+	text, markup, err := h.wizardPrompt(c, conv)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to render wizard")
+	}
+	if err := c.Respond(); err != nil {
+		return errs.WithOp(op, err, "failed to respond to callback")
+	}
+	return c.Edit(text, markup, tb.ModeMarkdownV2)
+}
+
+// finishCreateWizard creates the revise item collected by the wizard, ends the wizard
+// and returns the message announcing the item.
+func (h *Handler) finishCreateWizard(c tb.Context, conv conversation.Conversation, tags []string) (string, error) {
+	op := errs.Op("handler.finish_create_wizard")
+
+	msg, err := h.createReviseItem(c, conv.Value(wizardNameKey), conv.Value(wizardDescriptionKey), "", tags)
+	if err != nil {
+		return "", errs.WithOp(op, err, "failed to create item")
+	}
+	if err := h.conversations.Delete(context.TODO(), conv.ChatID()); err != nil {
+		return "", errs.WithOp(op, err, "failed to end conversation")
+	}
+	return msg, nil
+}
+
+// wizardPrompt renders the values collected so far and asks for the value of the current step.
+func (h *Handler) wizardPrompt(
+	c tb.Context,
+	conv conversation.Conversation,
+) (string, *tb.ReplyMarkup, error) {
+	op := errs.Op("handler.wizard_prompt")
+
+	p := h.printer(c)
+	markup := &tb.ReplyMarkup{}
+	cancel := button.Wizard(button.WizardCancelI, p.Sprintf("✖️ Cancel"))
+	back := button.Wizard(button.WizardBackI, p.Sprintf("◀️ Back"))
+
+	msg := strings.Builder{}
+	msg.WriteString(p.Sprintf("➕ *New Revise Item*") + "\n\n")
+	if conv.Step() != wizardStepName {
+		msg.WriteString(p.Sprintf("*Name:* %s", escapeMarkdown(conv.Value(wizardNameKey))) + "\n")
+	}
+	if description := conv.Value(wizardDescriptionKey); description != "" && conv.Step() == wizardStepTags {
+		msg.WriteString(p.Sprintf("*Description:* %s", escapeMarkdown(description)) + "\n")
+	}
+	picked := wizardTags(conv)
+	if len(picked) > 0 {
+		msg.WriteString(p.Sprintf("*Tags:* %s", escapeMarkdown(strings.Join(picked, ", "))) + "\n")
+	}
+	if conv.Step() != wizardStepName {
+		msg.WriteString("\n")
+	}
+
+	switch conv.Step() {
+	case wizardStepName:
+		msg.WriteString(p.Sprintf("✏️ Send the name of the new revise item"))
+		markup.InlineKeyboard = [][]tb.InlineButton{{cancel}}
+	case wizardStepDescription:
+		msg.WriteString(p.Sprintf("📝 Send its description, or skip it"))
+		markup.InlineKeyboard = [][]tb.InlineButton{
+			{button.Wizard(button.WizardSkipI, p.Sprintf("⏭ Skip"))},
+			{back, cancel},
+		}
+	case wizardStepTags:
+		userID, err := h.userID(c)
+		if err != nil {
+			return "", nil, errs.WithOp(op, err, "failed to get user id")
+		}
+		suggestions, err := h.app.ReviseItem.Query.ListUserTags.Handle(context.TODO(), query.ListUserTags{
+			UserID: userID,
+			Limit:  wizardTagSuggestions,
+		})
+		if err != nil {
+			return "", nil, errs.WithOp(op, err, "failed to list user tags")
+		}
+
+		var row []tb.InlineButton
+		for _, tag := range suggestions {
+			if len(tag) > wizardMaxTagBytes {
+				continue
+			}
+			text := tag
+			if slices.Contains(picked, tag) {
+				text = "☑️ " + tag
+			}
+			row = append(row, button.WizardTag(tag, text))
+			if len(row) == wizardTagsPerRow {
+				markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+				row = nil
+			}
+		}
+		if len(row) > 0 {
+			markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+		}
+
+		if len(markup.InlineKeyboard) > 0 {
+			msg.WriteString(p.Sprintf("🏷 Send its tags separated by commas, or pick them below"))
+		} else {
+			msg.WriteString(p.Sprintf("🏷 Send its tags separated by commas"))
+		}
+		markup.InlineKeyboard = append(markup.InlineKeyboard,
+			[]tb.InlineButton{button.Wizard(button.WizardCreateI, p.Sprintf("💾 Create"))},
+			[]tb.InlineButton{back, cancel},
+		)
+	}
+
+	return msg.String(), markup, nil
+}
+
+// wizardTags returns the tags picked in the create wizard.
+func wizardTags(conv conversation.Conversation) []string {
+	return splitTags(conv.Value(wizardTagsKey))
+}
@@ -1,28 +1,34 @@
 package handler
 
 import (
-	"fmt"
 	"strings"
 
 	tb "gopkg.in/telebot.v4"
 )
 
+// StartBot greets the user, the start links of the shared revise items open the item instead.
 func (h *Handler) StartBot(c tb.Context) error {
+	if payload := c.Message().Payload; strings.HasPrefix(payload, itemStartPrefix) {
+		return h.openSharedItem(c, payload)
+	}
+
+	p := h.printer(c)
+
 	startMsg := strings.Builder{}
-	startMsg.WriteString(fmt.Sprintf("Hello, *%s*\\!\n\n", c.Chat().FirstName))
-	startMsg.WriteString("👋 *Welcome to Go\\-Revise\\!*\n\n")
+	startMsg.WriteString(p.Sprintf("Hello, *%s*\\!", escapeMarkdown(c.Chat().FirstName)) + "\n\n")
+	startMsg.WriteString(p.Sprintf("👋 *Welcome to Go\\-Revise\\!*") + "\n\n")
 
-	startMsg.WriteString("*What this bot does:*\n")
-	startMsg.WriteString("• Help you retain information\n")
-	startMsg.WriteString("• Reinforce learning over time\n")
-	startMsg.WriteString("• Send revision reminders\n\n")
+	startMsg.WriteString(p.Sprintf("*What this bot does:*") + "\n")
+	startMsg.WriteString(p.Sprintf("• Help you retain information") + "\n")
+	startMsg.WriteString(p.Sprintf("• Reinforce learning over time") + "\n")
+	startMsg.WriteString(p.Sprintf("• Send revision reminders") + "\n\n")
 
-	startMsg.WriteString("*Getting Started:*\n")
-	startMsg.WriteString("1\\. Use /register to create account\n")
-	startMsg.WriteString("2\\. Add your study topics\n")
-	startMsg.WriteString("3\\. Let the bot handle your revision schedule\n\n")
+	startMsg.WriteString(p.Sprintf("*Getting Started:*") + "\n")
+	startMsg.WriteString(p.Sprintf("1\\. Use /register to create account") + "\n")
+	startMsg.WriteString(p.Sprintf("2\\. Add your study topics") + "\n")
+	startMsg.WriteString(p.Sprintf("3\\. Let the bot handle your revision schedule") + "\n\n")
 
-	startMsg.WriteString("_Ready to enhance your learning journey? Use /register to begin\\!_")
+	startMsg.WriteString(p.Sprintf("_Ready to enhance your learning journey? Use /register to begin\\!_"))
 
 	return c.Send(startMsg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
 }
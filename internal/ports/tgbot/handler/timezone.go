@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// Timezone shows the time zone of the user, or changes it if a new one is provided.
+//
+//	/timezone Asia/Almaty
+func (h *Handler) Timezone(c tb.Context) error {
+	op := errs.Op("handler.timezone")
+	chatID := user.TelegramID(c.Chat().ID)
+
+	name := strings.TrimSpace(c.Message().Payload)
+	if name != "" {
+		tz, err := valueobject.ParseTimezone(name)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to parse timezone")
+		}
+
+		err = h.app.User.Commands.ChangeSettings.Handle(
+			context.TODO(),
+			command.ChangeSettings{ChatID: chatID, Timezone: &tz},
+		)
+		if err != nil {
+			return errs.WithOp(op, err, "failed to change timezone")
+		}
+	}
+
+	queryUser, err := h.app.User.Queries.GetUser.Handle(
+		context.TODO(),
+		query.GetUser{ChatID: chatID},
+	)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to get user")
+	}
+
+	tz, err := valueobject.ParseTimezone(queryUser.Settings.Timezone)
+	if err != nil {
+		return errs.WithOp(op, err, "failed to parse user timezone")
+	}
+
+	p := h.printer(c)
+	msg := strings.Builder{}
+	if name != "" {
+		msg.WriteString(p.Sprintf("✅ *Timezone Updated*") + "\n\n")
+	} else {
+		msg.WriteString(p.Sprintf("🌍 *Timezone*") + "\n\n")
+	}
+	msg.WriteString(p.Sprintf("• Timezone: %s", escapeMarkdown(tz.String())) + "\n")
+	msg.WriteString(p.Sprintf("• Local time: %s", tz.In(time.Now()).Format("15:04")) + "\n")
+	msg.WriteString(p.Sprintf("• Reminders: %s",
+		escapeMarkdown(formatReminderSlots(p, queryUser.Settings.ReminderSlots))) + "\n\n")
+	msg.WriteString(p.Sprintf("*Usage:*") + "\n")
+	msg.WriteString(p.Sprintf("/timezone \\<IANA name\\>, e\\.g\\. /timezone Asia/Almaty") + "\n\n")
+	msg.WriteString(p.Sprintf("Note:") + "\n")
+	msg.WriteString(p.Sprintf("• Reminders and due days follow your local time"))
+
+	return c.Reply(msg.String(), &tb.SendOptions{ParseMode: tb.ModeMarkdownV2})
+}
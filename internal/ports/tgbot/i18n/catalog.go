@@ -0,0 +1,54 @@
+package i18n
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message/catalog"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+// The time layouts are messages too, so every language formats the dates its own way.
+const (
+	LayoutDate         = "Jan 2"
+	LayoutDateYear     = "Jan 2, 2006"
+	LayoutDateTime     = "Jan 2, 15:04 MST"
+	LayoutDateYearTime = "Jan 2, 2006 15:04 MST"
+)
+
+// en only has the plural forms, the other English messages are their keys.
+var en = map[string]catalog.Message{
+	"📚 You have *%d* revise items due:": plural.Selectf(1, "%d",
+		plural.One, "📚 You have *%d* revise item due:",
+		plural.Other, "📚 You have *%d* revise items due:",
+	),
+	"• Up to %d reminders per day": plural.Selectf(1, "%d",
+		plural.One, "• Up to %d reminder per day",
+		plural.Other, "• Up to %d reminders per day",
+	),
+}
+
+var messages = newCatalog()
+
+func newCatalog() *catalog.Builder {
+	b := catalog.NewBuilder(catalog.Fallback(valueobject.SupportedLanguages[0]))
+	for key, msg := range en {
+		must(b.Set(language.English, key, msg))
+	}
+	for tag, translations := range map[language.Tag]map[string]string{language.Russian: ru, language.Kazakh: kk} {
+		for key, msg := range translations {
+			must(b.SetString(tag, key, msg))
+		}
+	}
+	for key, msg := range ruPlurals {
+		must(b.Set(language.Russian, key, msg))
+	}
+	return b
+}
+
+// must panics on a malformed message, the catalog is built once on start up.
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
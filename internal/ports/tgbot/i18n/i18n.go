@@ -0,0 +1,50 @@
+// Package i18n translates the bot messages to the language of the user.
+//
+// The English text of a message is its key, so the English messages need no catalog entries
+// unless they are pluralised. The messages are MarkdownV2 where the bot sends them so,
+// the translations must keep the same escaping.
+package i18n
+
+import (
+	"golang.org/x/text/language"
+	"golang.org/x/text/language/display"
+	"golang.org/x/text/message"
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+// ContextKey is the key of the printer of the user in the telebot context.
+const ContextKey = "i18n.printer"
+
+// Name returns the name of the language in the language itself, e.g. "русский".
+func Name(tag language.Tag) string {
+	return display.Self.Name(tag)
+}
+
+// Printer returns the printer of the supported language closest to the given one.
+func Printer(tag language.Tag) *message.Printer {
+	return message.NewPrinter(valueobject.MatchLanguage(tag), message.Catalog(messages))
+}
+
+// ClientPrinter returns the printer of the language of the telegram client of the sender.
+func ClientPrinter(c tb.Context) *message.Printer {
+	if c == nil || c.Sender() == nil {
+		return Printer(valueobject.SupportedLanguages[0])
+	}
+	// an unknown or empty code falls back to English
+	tag, _ := language.Parse(c.Sender().LanguageCode)
+	return Printer(tag)
+}
+
+// FromContext returns the printer stored in the telebot context,
+// or the printer of the telegram client if there is none.
+func FromContext(c tb.Context) *message.Printer {
+	if c == nil {
+		return Printer(valueobject.SupportedLanguages[0])
+	}
+	if p, ok := c.Get(ContextKey).(*message.Printer); ok {
+		return p
+	}
+	return ClientPrinter(c)
+}
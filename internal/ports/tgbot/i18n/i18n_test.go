@@ -0,0 +1,119 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/text/language"
+)
+
+func TestPrinter_Plural(t *testing.T) {
+	t.Parallel()
+
+	const key = "📚 You have *%d* revise items due:"
+	tests := []struct {
+		tag      language.Tag
+		n        int
+		expected string
+	}{
+		{tag: language.English, n: 1, expected: "📚 You have *1* revise item due:"},
+		{tag: language.English, n: 5, expected: "📚 You have *5* revise items due:"},
+		{tag: language.Russian, n: 1, expected: "📚 Пора повторить *1* элемент:"},
+		{tag: language.Russian, n: 3, expected: "📚 Пора повторить *3* элемента:"},
+		{tag: language.Russian, n: 5, expected: "📚 Пора повторить *5* элементов:"},
+		{tag: language.Russian, n: 21, expected: "📚 Пора повторить *21* элемент:"},
+		{tag: language.Kazakh, n: 5, expected: "📚 Қайталайтын *5* элемент бар:"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, Printer(tt.tag).Sprintf(key, tt.n))
+		})
+	}
+}
+
+func TestPrinter_Fallback(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "Note:", Printer(language.Japanese).Sprintf("Note:"))
+	// the messages missing from the catalog are printed as they are
+	assert.Equal(t, "Unknown 1", Printer(language.Russian).Sprintf("Unknown %d", 1))
+}
+
+func TestCatalog_Complete(t *testing.T) {
+	t.Parallel()
+
+	for key := range ru {
+		assert.Contains(t, kk, key, "missing Kazakh translation")
+	}
+	for key := range kk {
+		_, isPlural := ruPlurals[key]
+		if !isPlural {
+			assert.Contains(t, ru, key, "missing Russian translation")
+		}
+	}
+}
+
+func TestCatalog_CodeKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := printedKeys(t, "..")
+	require.NotEmpty(t, keys, "no printed messages found")
+	for _, key := range keys {
+		_, isPlural := ruPlurals[key]
+		if !isPlural {
+			assert.Contains(t, ru, key, "missing Russian translation")
+		}
+		assert.Contains(t, kk, key, "missing Kazakh translation")
+	}
+}
+
+// printedKeys returns the string literals printed with Sprintf by the non-test code of the bot,
+// fmt.Sprintf is not translated so its calls are left out.
+func printedKeys(t *testing.T, root string) []string {
+	t.Helper()
+
+	var keys []string
+	fset := token.NewFileSet()
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Sprintf" {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "fmt" {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+			key, err := strconv.Unquote(lit.Value)
+			require.NoError(t, err)
+			keys = append(keys, key)
+			return true
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	return keys
+}
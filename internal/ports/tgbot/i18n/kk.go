@@ -0,0 +1,194 @@
+package i18n
+
+// kk has no plural forms, the nouns do not change after the numerals in Kazakh.
+var kk = map[string]string{
+	LayoutDate:         "02.01",
+	LayoutDateYear:     "02.01.2006",
+	LayoutDateTime:     "02.01 15:04 MST",
+	LayoutDateYearTime: "02.01.2006 15:04 MST",
+
+	// common
+	"*Usage:*":          "*Қолданылуы:*",
+	"⚠️ *Usage:*":       "⚠️ *Қолданылуы:*",
+	"*Example:*":        "*Мысал:*",
+	"Note:":             "Ескерту:",
+	"*Name:* %s":        "*Атауы:* %s",
+	"*Description:* %s": "*Сипаттама:* %s",
+	"*Tags:* %s":        "*Тегтер:* %s",
+	"*Source:* %s":      "*Дереккөз:* %s",
+	"off":               "өшірулі",
+
+	// start and registration
+	"Hello, *%s*\\!":                                                      "Сәлем, *%s*\\!",
+	"👋 *Welcome to Go\\-Revise\\!*":                                       "👋 *Go\\-Revise\\-қа қош келдіңіз\\!*",
+	"*What this bot does:*":                                               "*Бот не істейді:*",
+	"• Help you retain information":                                       "• Ақпаратты есте сақтауға көмектеседі",
+	"• Reinforce learning over time":                                      "• Білімді уақыт өте бекітеді",
+	"• Send revision reminders":                                           "• Қайталау туралы еске салады",
+	"*Getting Started:*":                                                  "*Қалай бастау керек:*",
+	"1\\. Use /register to create account":                                "1\\. /register арқылы аккаунт ашыңыз",
+	"2\\. Add your study topics":                                          "2\\. Оқитын тақырыптарыңызды қосыңыз",
+	"3\\. Let the bot handle your revision schedule":                      "3\\. Қайталау кестесін ботқа тапсырыңыз",
+	"_Ready to enhance your learning journey? Use /register to begin\\!_": "_Тиімді оқуға дайынсыз ба? /register арқылы бастаңыз\\!_",
+	"🔐 *Registration Confirmation*":                                       "🔐 *Тіркелуді растау*",
+	"*Data We Store:*":                                                    "*Біз сақтайтын деректер:*",
+	"• Your Telegram Chat ID":                                             "• Telegram чатыңыздың ID\\-і",
+	"• Revision items you create:":                                        "• Сіз құрған элементтер:",
+	"Item names":                                                          "Элемент атаулары",
+	"Descriptions":                                                        "Сипаттамалар",
+	"Custom tags":                                                         "Өз тегтеріңіз",
+	"Creation dates":                                                      "Құрылған күндері",
+	"Revision schedules":                                                  "Қайталау кестелері",
+	"✅ Confirm":                                                           "✅ Растау",
+	"✅ *Registration Confirmed*":                                          "✅ *Тіркелу расталды*",
+	"🎉 *Welcome\\!* Your registration is complete\\.":                     "🎉 *Қош келдіңіз\\!* Тіркелу аяқталды\\.",
+	"*What you can do now:*":                                              "*Енді не істеуге болады:*",
+	"• Use /help to see available commands":                               "• Командалар тізімі: /help",
+	"• Set up your preferences with /settings":                            "• Баптауларды /settings арқылы орнатыңыз",
+	"• Change the language with /language":                                "• Тілді /language арқылы өзгертіңіз",
+	"• Start exploring with /menu":                                        "• /menu арқылы танысып шығыңыз",
+
+	// revise items
+	"Message from %s":                                         "%s жіберген хабарлама",
+	"➕ *New Revise Item*":                                     "➕ *Жаңа қайталау элементі*",
+	"✏️ Send the name of the new revise item":                 "✏️ Жаңа элементтің атауын жіберіңіз",
+	"📝 Send its description, or skip it":                      "📝 Оның сипаттамасын жіберіңіз немесе бұл қадамды өткізіп жіберіңіз",
+	"🏷 Send its tags separated by commas":                     "🏷 Оның тегтерін үтір арқылы жіберіңіз",
+	"🏷 Send its tags separated by commas, or pick them below": "🏷 Оның тегтерін үтір арқылы жіберіңіз немесе төменнен таңдаңыз",
+	"⏭ Skip":    "⏭ Өткізу",
+	"💾 Create":  "💾 Құру",
+	"✖️ Cancel": "✖️ Бас тарту",
+	"This revise item creation is over, start a new one with /revise_create": "Элементті құру аяқталған, /revise_create арқылы қайта бастаңыз",
+	"✅ *Revision Item Created*":                                              "✅ *Қайталау элементі құрылды*",
+	"Use /list to see all your items":                                        "Барлық элементтеріңіз: /list",
+	"Postponed until %s":                                                     "%s дейін кейінге қалдырылды",
+
+	// digest
+	"Hello, %s\\!":                                         "Сәлем, %s\\!",
+	"📚 You have *%d* revise items due:":                    "📚 Қайталайтын *%d* элемент бар:",
+	"🎉 You are done for today, no revise items are due\\.": "🎉 Бүгінге бітті, қайталайтын элементтер жоқ\\.",
+	"✅ reviewed · ⏰ snooze for an hour · 📖 open · ⏭ skip until tomorrow": "✅ қайталандым · ⏰ бір сағатқа қалдыру · 📖 ашу · ⏭ ертеңге дейін өткізу",
+	"Page %d/%d":                    "Бет %d/%d",
+	"◀️ Previous":                   "◀️ Артқа",
+	"Next ▶️":                       "Келесі ▶️",
+	"Reviewed, next revision on %s": "Қайталанды, келесі қайталау %s",
+	"• Tags: %s":                    "• Тегтер: %s",
+	"• Source: %s":                  "• Дереккөз: %s",
+	"• Revisions: %d":               "• Қайталаулар саны: %d",
+	"• Last revised: %s":            "• Соңғы қайталау: %s",
+	"• Due: %s":                     "• Қайталау мерзімі: %s",
+
+	// settings
+	"📊 *Daily Quota*":                      "📊 *Күндік квота*",
+	"✅ *Daily Quota Updated*":              "✅ *Күндік квота жаңартылды*",
+	"• Reviews: %s":                        "• Қайталаулар: %s",
+	"• New items: %s":                      "• Жаңа элементтер: %s",
+	"/quota \\<reviews\\> \\[new items\\]": "/quota \\<қайталаулар\\> \\[жаңа элементтер\\]",
+	"• 0 means no limit":                   "• 0 — шектеусіз",
+	"• Items over the quota are carried over to the next day, the most overdue first": "• Квотадан асқан элементтер келесі күнге ауысады, ең кешіккендері бірінші",
+	"no limit":                     "шектеусіз",
+	"⏰ *Reminders*":                "⏰ *Еске салғыштар*",
+	"✅ *Reminders Updated*":        "✅ *Еске салғыштар жаңартылды*",
+	"• Reminders: %s":              "• Еске салғыштар: %s",
+	"• Quiet hours: %s":            "• Тыныш сағаттар: %s",
+	"• Timezone: %s":               "• Уақыт белдеуі: %s",
+	"• Up to %d reminders per day": "• Күніне %d еске салғышқа дейін",
+	"• Weekdays: daily, workdays, weekends or a list like mon,wed,fri": "• Күндер: daily, workdays, weekends немесе mon,wed,fri сияқты тізім",
+	"• No reminders are sent during the quiet hours":                   "• Тыныш сағаттарда еске салғыштар жіберілмейді",
+	"🌍 *Timezone*":         "🌍 *Уақыт белдеуі*",
+	"✅ *Timezone Updated*": "✅ *Уақыт белдеуі жаңартылды*",
+	"• Local time: %s":     "• Жергілікті уақыт: %s",
+	"/timezone \\<IANA name\\>, e\\.g\\. /timezone Asia/Almaty":                               "/timezone \\<IANA атауы\\>, мысалы /timezone Asia/Almaty",
+	"• Reminders and due days follow your local time":                                         "• Еске салғыштар мен мерзімдер жергілікті уақытыңыз бойынша",
+	"🗓 *Review Intervals*":                                                                    "🗓 *Қайталау интервалдары*",
+	"✅ *Review Intervals Updated*":                                                            "✅ *Қайталау интервалдары жаңартылды*",
+	"• Units: m \\- minutes, h \\- hours, d \\- days, w \\- weeks, M \\- months, y \\- years": "• Бірліктер: m \\- минут, h \\- сағат, d \\- күн, w \\- апта, M \\- ай, y \\- жыл",
+	"• Missing intervals are taken from the default ones":                                     "• Жетпейтін интервалдар әдепкілерден алынады",
+	"📣 *Channels*":                         "📣 *Арналар*",
+	"✅ *Channels Updated*":                 "✅ *Арналар жаңартылды*",
+	"• Channels: %s":                       "• Арналар: %s",
+	"• Email: %s":                          "• Пошта: %s",
+	"• Webhook: %s":                        "• Вебхук: %s",
+	"• Channels: telegram, email, webhook": "• Арналар: telegram, email, webhook",
+	"• An email address or a webhook url turns its channel on": "• Пошта мекенжайы немесе вебхук url\\-і өз арнасын қосады",
+	"*Webhook secret:*": "*Вебхук құпиясы:*",
+	"_Copy it now, it is not shown again\\. Verify the X\\-Revise\\-Signature header of the payloads with it_": "_Оны қазір көшіріп алыңыз, ол қайта көрсетілмейді\\. Онымен X\\-Revise\\-Signature тақырыбын тексеріңіз_",
+	"🌐 *Language*":                              "🌐 *Тіл*",
+	"✅ *Language Updated*":                      "✅ *Тіл жаңартылды*",
+	"• Language: %s":                            "• Тіл: %s",
+	"• Languages: %s":                           "• Тілдер: %s",
+	"• Reminders are sent in the same language": "• Еске салғыштар осы тілде жіберіледі",
+
+	// interval profiles
+	"🗂 *Interval Profiles*":                  "🗂 *Интервал профильдері*",
+	"_You have no interval profiles yet\\._": "_Сізде әзірге интервал профильдері жоқ\\._",
+	"attach, or detach without a name":       "тіркеу, атаусыз — ажырату",
+	"✅ *Interval Profile Created*":           "✅ *Интервал профилі құрылды*",
+	"✅ *Interval profile detached from* %s":  "✅ *Интервал профилі ажыратылды:* %s",
+	"✅ *%s* is attached to %s":               "✅ *%s* %s тегіне тіркелді",
+	"🗑 *Interval Profile Deleted*":           "🗑 *Интервал профилі жойылды*",
+
+	// api tokens
+	"🔑 *API Tokens*":                  "🔑 *API токендері*",
+	"_You have no API tokens yet\\._": "_Сізде әзірге API токендері жоқ\\._",
+	"• Scope: %s":                     "• Қолжетімділік: %s",
+	"• Created: %s":                   "• Құрылды: %s",
+	"• Last used: %s":                 "• Соңғы қолданылуы: %s",
+	"🗑 Revoke %s":                     "🗑 %s кері қайтарып алу",
+	"use the token with the REST API outside Telegram": "токенді Telegram\\-нан тыс REST API\\-мен қолданыңыз",
+	"🔑 *API Token Created*":                            "🔑 *API токені құрылды*",
+	"*Scope:* %s":                                      "*Қолжетімділік:* %s",
+	"_Copy it now, it is not shown again\\. Send it as_ `Authorization: Bearer <token>`": "_Оны қазір көшіріп алыңыз, ол қайта көрсетілмейді\\. Оны былай жіберіңіз:_ `Authorization: Bearer <token>`",
+	"read and write": "оқу және жазу",
+	"read only":      "тек оқу",
+	"Revoked":        "Кері қайтарылды",
+
+	// item management
+	"Register to search your items":                       "Элементтеріңізді іздеу үшін тіркеліңіз",
+	"📖 Open in the bot":                                   "📖 Ботта ашу",
+	"📋 *Revise Items*":                                    "📋 *Қайталау элементтері*",
+	"_You have no revise items yet\\._":                   "_Сізде әзірге қайталау элементтері жоқ\\._",
+	"Create one with /revise\\_create":                    "Біріншісін /revise\\_create арқылы құрыңыз",
+	"_due %s_":                                            "_мерзімі %s_",
+	"_Rate how well you remember it to mark it reviewed_": "_Қайталанды деп белгілеу үшін қаншалықты жақсы есте сақтағаныңызды бағалаңыз_",
+	"😣 Again":                                             "😣 Ұмыттым",
+	"😐 Hard":                                              "😐 Қиын",
+	"🙂 Good":                                              "🙂 Жақсы",
+	"😄 Easy":                                              "😄 Оңай",
+	"✏️ Rename":                                           "✏️ Атын өзгерту",
+	"📝 Description":                                       "📝 Сипаттама",
+	"🏷 Add tags":                                          "🏷 Тег қосу",
+	"✖️ Remove tags":                                      "✖️ Тегті алып тастау",
+	"🗑 Delete":                                            "🗑 Жою",
+	"📋 Back to list":                                      "📋 Тізімге",
+	"◀️ Back":                                             "◀️ Артқа",
+	"Removed the %s tag":                                  "%s тегі алып тасталды",
+	"🗑 *%s* is deleted":                                   "🗑 *%s* жойылды",
+	"↩️ Undo":                                             "↩️ Болдырмау",
+	"Restored":                                            "Қалпына келтірілді",
+	"✏️ Send the new name of *%s*":                        "✏️ *%s* үшін жаңа атау жіберіңіз",
+	"📝 Send the new description of *%s*, or \\- to clear it": "📝 *%s* үшін жаңа сипаттама жіберіңіз, тазалау үшін \\- жіберіңіз",
+	"🏷 Send the tags to add to *%s*, separated by commas":    "🏷 *%s* үшін тегтерді үтір арқылы жіберіңіз",
+	"_Send /cancel to keep it as it is_":                     "_Өзгертпеу үшін /cancel жіберіңіз_",
+	"✅ *Revise Item Updated*":                                "✅ *Элемент жаңартылды*",
+	"Nothing to cancel":                                      "Болдырмайтын ештеңе жоқ",
+	"Cancelled":                                              "Болдырылмады",
+
+	// errors, sent as plain text
+	"😕 Oops! %s":                                             "😕 Ойбай! %s",
+	"Try: %s":                                                "Кеңес: %s",
+	"The provided input format is incorrect":                 "Енгізу пішімі дұрыс емес",
+	"We couldn't find what you're looking for":               "Іздегеніңізді таба алмадық",
+	"There's a conflict with existing data":                  "Бар деректермен қайшылық бар",
+	"This item already exists":                               "Бұл элемент бұрыннан бар",
+	"You need to be authorized for this action":              "Бұл әрекет үшін авторизация қажет",
+	"You don't have permission for this action":              "Бұл әрекетке рұқсатыңыз жоқ",
+	"Something went wrong on our end":                        "Біз жақта бірдеңе дұрыс болмады",
+	"Double-check your input format and try again":           "Енгізу пішімін тексеріп, қайталап көріңіз",
+	"Verify the ID or search term and try again":             "ID немесе іздеу сөзін тексеріп, қайталап көріңіз",
+	"Review your data and try a different value":             "Деректеріңізді тексеріп, басқа мән енгізіңіз",
+	"Use a different identifier or update the existing item": "Басқа идентификатор қолданыңыз немесе бар элементті жаңартыңыз",
+	"Log in and try again":                                   "Кіріп, қайталап көріңіз",
+	"Contact an administrator for access":                    "Қолжетімділік үшін әкімшіге хабарласыңыз",
+	"Please try again later or contact support":              "Кейінірек қайталап көріңіз немесе қолдау қызметіне жазыңыз",
+}
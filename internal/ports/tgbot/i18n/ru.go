@@ -0,0 +1,209 @@
+package i18n
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/message/catalog"
+)
+
+var ruPlurals = map[string]catalog.Message{
+	"📚 You have *%d* revise items due:": plural.Selectf(1, "%d",
+		plural.One, "📚 Пора повторить *%d* элемент:",
+		plural.Few, "📚 Пора повторить *%d* элемента:",
+		plural.Many, "📚 Пора повторить *%d* элементов:",
+		plural.Other, "📚 Пора повторить *%d* элемента:",
+	),
+	"• Up to %d reminders per day": plural.Selectf(1, "%d",
+		plural.One, "• До %d напоминания в день",
+		plural.Other, "• До %d напоминаний в день",
+	),
+}
+
+var ru = map[string]string{
+	LayoutDate:         "02.01",
+	LayoutDateYear:     "02.01.2006",
+	LayoutDateTime:     "02.01 15:04 MST",
+	LayoutDateYearTime: "02.01.2006 15:04 MST",
+
+	// common
+	"*Usage:*":          "*Использование:*",
+	"⚠️ *Usage:*":       "⚠️ *Использование:*",
+	"*Example:*":        "*Пример:*",
+	"Note:":             "Примечание:",
+	"*Name:* %s":        "*Название:* %s",
+	"*Description:* %s": "*Описание:* %s",
+	"*Tags:* %s":        "*Теги:* %s",
+	"*Source:* %s":      "*Источник:* %s",
+	"off":               "выкл",
+
+	// start and registration
+	"Hello, *%s*\\!":                                                      "Привет, *%s*\\!",
+	"👋 *Welcome to Go\\-Revise\\!*":                                       "👋 *Добро пожаловать в Go\\-Revise\\!*",
+	"*What this bot does:*":                                               "*Что умеет бот:*",
+	"• Help you retain information":                                       "• Помогает запоминать информацию",
+	"• Reinforce learning over time":                                      "• Закрепляет знания со временем",
+	"• Send revision reminders":                                           "• Напоминает о повторении",
+	"*Getting Started:*":                                                  "*С чего начать:*",
+	"1\\. Use /register to create account":                                "1\\. Создайте аккаунт командой /register",
+	"2\\. Add your study topics":                                          "2\\. Добавьте темы для изучения",
+	"3\\. Let the bot handle your revision schedule":                      "3\\. Бот сам составит расписание повторений",
+	"_Ready to enhance your learning journey? Use /register to begin\\!_": "_Готовы учиться эффективнее? Начните с /register\\!_",
+	"🔐 *Registration Confirmation*":                                       "🔐 *Подтверждение регистрации*",
+	"*Data We Store:*":                                                    "*Какие данные мы храним:*",
+	"• Your Telegram Chat ID":                                             "• ID вашего чата в Telegram",
+	"• Revision items you create:":                                        "• Созданные вами элементы:",
+	"Item names":                                                          "Названия элементов",
+	"Descriptions":                                                        "Описания",
+	"Custom tags":                                                         "Ваши теги",
+	"Creation dates":                                                      "Даты создания",
+	"Revision schedules":                                                  "Расписания повторений",
+	"✅ Confirm":                                                           "✅ Подтвердить",
+	"✅ *Registration Confirmed*":                                          "✅ *Регистрация подтверждена*",
+	"🎉 *Welcome\\!* Your registration is complete\\.":                     "🎉 *Добро пожаловать\\!* Регистрация завершена\\.",
+	"*What you can do now:*":                                              "*Что можно сделать сейчас:*",
+	"• Use /help to see available commands":                               "• Список команд: /help",
+	"• Set up your preferences with /settings":                            "• Настройте бота через /settings",
+	"• Change the language with /language":                                "• Смените язык командой /language",
+	"• Start exploring with /menu":                                        "• Осмотритесь в /menu",
+
+	// revise items
+	"Message from %s":                                         "Сообщение от %s",
+	"➕ *New Revise Item*":                                     "➕ *Новый элемент для повторения*",
+	"✏️ Send the name of the new revise item":                 "✏️ Отправьте название нового элемента",
+	"📝 Send its description, or skip it":                      "📝 Отправьте его описание или пропустите этот шаг",
+	"🏷 Send its tags separated by commas":                     "🏷 Отправьте его теги через запятую",
+	"🏷 Send its tags separated by commas, or pick them below": "🏷 Отправьте его теги через запятую или выберите их ниже",
+	"⏭ Skip":    "⏭ Пропустить",
+	"💾 Create":  "💾 Создать",
+	"✖️ Cancel": "✖️ Отмена",
+	"This revise item creation is over, start a new one with /revise_create": "Создание элемента уже завершено, начните заново с /revise_create",
+	"✅ *Revision Item Created*":                                              "✅ *Элемент для повторения создан*",
+	"Use /list to see all your items":                                        "Все ваши элементы: /list",
+	"Postponed until %s":                                                     "Отложено до %s",
+
+	// digest
+	"Hello, %s\\!": "Привет, %s\\!",
+	"🎉 You are done for today, no revise items are due\\.":               "🎉 На сегодня всё, повторять больше нечего\\.",
+	"✅ reviewed · ⏰ snooze for an hour · 📖 open · ⏭ skip until tomorrow": "✅ повторено · ⏰ отложить на час · 📖 открыть · ⏭ пропустить до завтра",
+	"Page %d/%d":                    "Страница %d/%d",
+	"◀️ Previous":                   "◀️ Назад",
+	"Next ▶️":                       "Далее ▶️",
+	"Reviewed, next revision on %s": "Повторено, следующее повторение %s",
+	"• Tags: %s":                    "• Теги: %s",
+	"• Source: %s":                  "• Источник: %s",
+	"• Revisions: %d":               "• Повторений: %d",
+	"• Last revised: %s":            "• Последнее повторение: %s",
+	"• Due: %s":                     "• Повторить: %s",
+
+	// settings
+	"📊 *Daily Quota*":                      "📊 *Дневная квота*",
+	"✅ *Daily Quota Updated*":              "✅ *Дневная квота обновлена*",
+	"• Reviews: %s":                        "• Повторения: %s",
+	"• New items: %s":                      "• Новые элементы: %s",
+	"/quota \\<reviews\\> \\[new items\\]": "/quota \\<повторения\\> \\[новые элементы\\]",
+	"• 0 means no limit":                   "• 0 — без ограничений",
+	"• Items over the quota are carried over to the next day, the most overdue first": "• Элементы сверх квоты переносятся на следующий день, самые просроченные первыми",
+	"no limit":              "без ограничений",
+	"⏰ *Reminders*":         "⏰ *Напоминания*",
+	"✅ *Reminders Updated*": "✅ *Напоминания обновлены*",
+	"• Reminders: %s":       "• Напоминания: %s",
+	"• Quiet hours: %s":     "• Тихие часы: %s",
+	"• Timezone: %s":        "• Часовой пояс: %s",
+	"• Weekdays: daily, workdays, weekends or a list like mon,wed,fri": "• Дни: daily, workdays, weekends или список вроде mon,wed,fri",
+	"• No reminders are sent during the quiet hours":                   "• В тихие часы напоминания не отправляются",
+	"🌍 *Timezone*":         "🌍 *Часовой пояс*",
+	"✅ *Timezone Updated*": "✅ *Часовой пояс обновлён*",
+	"• Local time: %s":     "• Местное время: %s",
+	"/timezone \\<IANA name\\>, e\\.g\\. /timezone Asia/Almaty":                               "/timezone \\<имя IANA\\>, например /timezone Asia/Almaty",
+	"• Reminders and due days follow your local time":                                         "• Напоминания и сроки считаются по вашему местному времени",
+	"🗓 *Review Intervals*":                                                                    "🗓 *Интервалы повторения*",
+	"✅ *Review Intervals Updated*":                                                            "✅ *Интервалы повторения обновлены*",
+	"• Units: m \\- minutes, h \\- hours, d \\- days, w \\- weeks, M \\- months, y \\- years": "• Единицы: m \\- минуты, h \\- часы, d \\- дни, w \\- недели, M \\- месяцы, y \\- годы",
+	"• Missing intervals are taken from the default ones":                                     "• Недостающие интервалы берутся из стандартных",
+	"📣 *Channels*":                         "📣 *Каналы*",
+	"✅ *Channels Updated*":                 "✅ *Каналы обновлены*",
+	"• Channels: %s":                       "• Каналы: %s",
+	"• Email: %s":                          "• Почта: %s",
+	"• Webhook: %s":                        "• Вебхук: %s",
+	"• Channels: telegram, email, webhook": "• Каналы: telegram, email, webhook",
+	"• An email address or a webhook url turns its channel on": "• Адрес почты или url вебхука включает свой канал",
+	"*Webhook secret:*": "*Секрет вебхука:*",
+	"_Copy it now, it is not shown again\\. Verify the X\\-Revise\\-Signature header of the payloads with it_": "_Скопируйте его сейчас, он больше не будет показан\\. Проверяйте им заголовок X\\-Revise\\-Signature_",
+	"🌐 *Language*":                              "🌐 *Язык*",
+	"✅ *Language Updated*":                      "✅ *Язык обновлён*",
+	"• Language: %s":                            "• Язык: %s",
+	"• Languages: %s":                           "• Языки: %s",
+	"• Reminders are sent in the same language": "• Напоминания приходят на этом же языке",
+
+	// interval profiles
+	"🗂 *Interval Profiles*":                  "🗂 *Профили интервалов*",
+	"_You have no interval profiles yet\\._": "_У вас пока нет профилей интервалов\\._",
+	"attach, or detach without a name":       "привязать, без названия — отвязать",
+	"✅ *Interval Profile Created*":           "✅ *Профиль интервалов создан*",
+	"✅ *Interval profile detached from* %s":  "✅ *Профиль интервалов отвязан от* %s",
+	"✅ *%s* is attached to %s":               "✅ *%s* привязан к %s",
+	"🗑 *Interval Profile Deleted*":           "🗑 *Профиль интервалов удалён*",
+
+	// api tokens
+	"🔑 *API Tokens*":                  "🔑 *API\\-токены*",
+	"_You have no API tokens yet\\._": "_У вас пока нет API\\-токенов\\._",
+	"• Scope: %s":                     "• Доступ: %s",
+	"• Created: %s":                   "• Создан: %s",
+	"• Last used: %s":                 "• Последнее использование: %s",
+	"🗑 Revoke %s":                     "🗑 Отозвать %s",
+	"use the token with the REST API outside Telegram": "используйте токен с REST API вне Telegram",
+	"🔑 *API Token Created*":                            "🔑 *API\\-токен создан*",
+	"*Scope:* %s":                                      "*Доступ:* %s",
+	"_Copy it now, it is not shown again\\. Send it as_ `Authorization: Bearer <token>`": "_Скопируйте его сейчас, он больше не будет показан\\. Передавайте его как_ `Authorization: Bearer <token>`",
+	"read and write": "чтение и запись",
+	"read only":      "только чтение",
+	"Revoked":        "Отозван",
+
+	// item management
+	"Register to search your items":                       "Зарегистрируйтесь, чтобы искать свои элементы",
+	"📖 Open in the bot":                                   "📖 Открыть в боте",
+	"📋 *Revise Items*":                                    "📋 *Элементы для повторения*",
+	"_You have no revise items yet\\._":                   "_У вас пока нет элементов для повторения\\._",
+	"Create one with /revise\\_create":                    "Создайте первый командой /revise\\_create",
+	"_due %s_":                                            "_повторить %s_",
+	"_Rate how well you remember it to mark it reviewed_": "_Оцените, насколько хорошо вы помните, чтобы отметить повторение_",
+	"😣 Again":                                             "😣 Забыл",
+	"😐 Hard":                                              "😐 Трудно",
+	"🙂 Good":                                              "🙂 Хорошо",
+	"😄 Easy":                                              "😄 Легко",
+	"✏️ Rename":                                           "✏️ Переименовать",
+	"📝 Description":                                       "📝 Описание",
+	"🏷 Add tags":                                          "🏷 Добавить теги",
+	"✖️ Remove tags":                                      "✖️ Убрать теги",
+	"🗑 Delete":                                            "🗑 Удалить",
+	"📋 Back to list":                                      "📋 К списку",
+	"◀️ Back":                                             "◀️ Назад",
+	"Removed the %s tag":                                  "Тег %s убран",
+	"🗑 *%s* is deleted":                                   "🗑 *%s* удалён",
+	"↩️ Undo":                                             "↩️ Отменить",
+	"Restored":                                            "Восстановлено",
+	"✏️ Send the new name of *%s*":                        "✏️ Отправьте новое название для *%s*",
+	"📝 Send the new description of *%s*, or \\- to clear it": "📝 Отправьте новое описание для *%s* или \\-, чтобы очистить его",
+	"🏷 Send the tags to add to *%s*, separated by commas":    "🏷 Отправьте теги для *%s* через запятую",
+	"_Send /cancel to keep it as it is_":                     "_Отправьте /cancel, чтобы ничего не менять_",
+	"✅ *Revise Item Updated*":                                "✅ *Элемент обновлён*",
+	"Nothing to cancel":                                      "Нечего отменять",
+	"Cancelled":                                              "Отменено",
+
+	// errors, sent as plain text
+	"😕 Oops! %s":                                             "😕 Упс! %s",
+	"Try: %s":                                                "Попробуйте: %s",
+	"The provided input format is incorrect":                 "Неверный формат ввода",
+	"We couldn't find what you're looking for":               "Мы не нашли то, что вы ищете",
+	"There's a conflict with existing data":                  "Конфликт с существующими данными",
+	"This item already exists":                               "Такой элемент уже существует",
+	"You need to be authorized for this action":              "Для этого действия нужна авторизация",
+	"You don't have permission for this action":              "У вас нет прав на это действие",
+	"Something went wrong on our end":                        "Что-то пошло не так на нашей стороне",
+	"Double-check your input format and try again":           "Проверьте формат ввода и попробуйте снова",
+	"Verify the ID or search term and try again":             "Проверьте ID или запрос и попробуйте снова",
+	"Review your data and try a different value":             "Проверьте данные и попробуйте другое значение",
+	"Use a different identifier or update the existing item": "Используйте другой идентификатор или обновите существующий элемент",
+	"Log in and try again":                                   "Войдите и попробуйте снова",
+	"Contact an administrator for access":                    "Обратитесь к администратору за доступом",
+	"Please try again later or contact support":              "Попробуйте позже или обратитесь в поддержку",
+}
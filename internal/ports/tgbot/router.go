@@ -1,12 +1,61 @@
 package tgbot
 
-import "github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+import (
+	tb "gopkg.in/telebot.v4"
+
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/button"
+)
 
 func (p *Port) setUpRouter() {
+	p.bot.Use(p.handler.Localize)
+
 	p.bot.Handle("/start", p.handler.StartBot)
 
 	p.bot.Handle("/register", p.handler.RegisterUser)
 	p.bot.Handle(&button.RegistrationConfirmI, p.handler.RegisterUserConfirmed)
 
 	p.bot.Handle("/revise_create", p.handler.CreateItem)
+	p.bot.Handle(&button.WizardSkipI, p.handler.WizardSkip)
+	p.bot.Handle(&button.WizardBackI, p.handler.WizardBack)
+	p.bot.Handle(&button.WizardTagI, p.handler.WizardTag)
+	p.bot.Handle(&button.WizardCreateI, p.handler.WizardCreate)
+	p.bot.Handle(&button.WizardCancelI, p.handler.WizardCancel)
+	p.bot.Handle("/list", p.handler.ListItems)
+	p.bot.Handle("/cancel", p.handler.Cancel)
+	p.bot.Handle(tb.OnText, p.handler.Text)
+	p.bot.Handle(tb.OnForward, p.handler.Forward)
+	p.bot.Handle(tb.OnQuery, p.handler.SearchItems)
+	p.bot.Handle(&button.ItemListPageI, p.handler.ItemListPage)
+	p.bot.Handle(&button.ItemOpenI, p.handler.ItemOpen)
+	p.bot.Handle(&button.ItemReviewI, p.handler.ItemReview)
+	p.bot.Handle(&button.ItemRenameI, p.handler.ItemRename)
+	p.bot.Handle(&button.ItemDescribeI, p.handler.ItemDescribe)
+	p.bot.Handle(&button.ItemTagI, p.handler.ItemTag)
+	p.bot.Handle(&button.ItemUntagI, p.handler.ItemUntag)
+	p.bot.Handle(&button.ItemDeleteI, p.handler.ItemDelete)
+	p.bot.Handle(&button.ItemRestoreI, p.handler.ItemRestore)
+	p.bot.Handle(&button.PostponeI, p.handler.PostponeItem)
+
+	p.bot.Handle(&button.DigestReviewedI, p.handler.DigestReviewed)
+	p.bot.Handle(&button.DigestSnoozeI, p.handler.DigestSnooze)
+	p.bot.Handle(&button.DigestOpenI, p.handler.DigestOpen)
+	p.bot.Handle(&button.DigestSkipI, p.handler.DigestSkip)
+	p.bot.Handle(&button.DigestPageI, p.handler.DigestPage)
+
+	p.bot.Handle("/intervals", p.handler.ReviewIntervals)
+	p.bot.Handle("/quota", p.handler.DailyQuota)
+	p.bot.Handle("/timezone", p.handler.Timezone)
+	p.bot.Handle("/reminders", p.handler.Reminders)
+	p.bot.Handle("/quiet", p.handler.QuietHours)
+	p.bot.Handle("/channels", p.handler.Channels)
+	p.bot.Handle("/language", p.handler.Language)
+
+	p.bot.Handle("/profiles", p.handler.ListIntervalProfiles)
+	p.bot.Handle("/profile_create", p.handler.CreateIntervalProfile)
+	p.bot.Handle("/profile_tag", p.handler.TagIntervalProfile)
+	p.bot.Handle("/profile_delete", p.handler.DeleteIntervalProfile)
+
+	p.bot.Handle("/tokens", p.handler.ListAPITokens)
+	p.bot.Handle("/token_create", p.handler.CreateAPIToken)
+	p.bot.Handle(&button.TokenRevokeI, p.handler.RevokeAPIToken)
 }
@@ -2,18 +2,18 @@ package tgbot
 
 import (
 	"context"
-	"fmt"
 	"log/slog"
 	"net/http"
-	"strings"
 
 	tb "gopkg.in/telebot.v4"
 
 	"github.com/ARUMANDESU/go-revise/internal/application"
 	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/conversation"
 	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
 	domainUser "github.com/ARUMANDESU/go-revise/internal/domain/user"
 	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/handler"
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
 	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/tgboterr"
 	"github.com/ARUMANDESU/go-revise/pkg/env"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
@@ -26,7 +26,7 @@ type Port struct {
 	handler       *handler.Handler
 }
 
-func NewPort(cfg config.Telegram, app application.Application) (Port, error) {
+func NewPort(cfg config.Telegram, app application.Application, conversations conversation.Repository) (Port, error) {
 	httpClient := http.Client{}
 
 	webhookPoller := tb.Webhook{
@@ -57,7 +57,7 @@ func NewPort(cfg config.Telegram, app application.Application) (Port, error) {
 		bot:           bot,
 		webhookPoller: &webhookPoller,
 		httpClient:    &httpClient,
-		handler:       handler.NewHandler(app),
+		handler:       handler.NewHandler(app, conversations),
 	}, nil
 }
 
@@ -87,30 +87,13 @@ func (p *Port) Notify(
 		return errs.WithOp(op, err, "failed to get chat").WithContext("chat_id", user.ChatID())
 	}
 
-	msg := strings.Builder{}
-	msg.WriteString(fmt.Sprintf("Hello, %s!\n", chat.FirstName))
-	msg.WriteString("You have the following revise items due:\n")
-
-	_, err = p.bot.Send(tb.ChatID(user.ChatID()), msg.String())
+	// a single digest instead of a message per item keeps the chat readable and within the rate limits
+	printer := i18n.Printer(user.Settings().Language)
+	text, markup := handler.Digest(printer, chat.FirstName, handler.DigestItemsFromDomain(reviseItems), 0)
+	_, err = p.bot.Send(tb.ChatID(user.ChatID()), text, markup, tb.ModeMarkdownV2)
 	if err != nil {
 		return errs.WithOp(op, err, "failed to notify user")
 	}
 
-	for _, reviseItem := range reviseItems {
-		reviseItemMsg := strings.Builder{}
-		reviseItemMsg.WriteString(fmt.Sprintf("Name: %s\n", reviseItem.Name()))
-		if reviseItem.Description() != "" {
-			reviseItemMsg.WriteString(fmt.Sprintf("Description: %s\n", reviseItem.Description()))
-		}
-		tags := reviseItem.Tags()
-		if !tags.IsEmpty() {
-			reviseItemMsg.WriteString(fmt.Sprintf("Tags: %s\n", tags.String()))
-		}
-		_, err = p.bot.Send(tb.ChatID(user.ChatID()), reviseItemMsg.String())
-		if err != nil {
-			return errs.WithOp(op, err, "failed to notify user")
-		}
-	}
-
 	return nil
 }
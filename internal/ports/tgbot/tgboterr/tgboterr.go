@@ -5,8 +5,10 @@ import (
 	"log/slog"
 	"strings"
 
+	"golang.org/x/text/message"
 	tb "gopkg.in/telebot.v4"
 
+	"github.com/ARUMANDESU/go-revise/internal/ports/tgbot/i18n"
 	"github.com/ARUMANDESU/go-revise/pkg/errs"
 	"github.com/ARUMANDESU/go-revise/pkg/logutil"
 )
@@ -41,16 +43,18 @@ func OnError(err error, c tb.Context) {
 	}
 }
 
+// sendError sends the error in the language of the user,
+// the messages of the errors themselves come from the domain and are not translated.
 func sendError(c tb.Context, errType, msg string) {
-	errorMessage := strings.Builder{}
-	errorMessage.WriteString("😕 Oops! ")
-	if msg != "" {
-		errorMessage.WriteString(msg)
-	} else {
-		errorMessage.WriteString(getDefaultMessage(errType))
+	p := i18n.FromContext(c)
+	if msg == "" {
+		msg = getDefaultMessage(p, errType)
 	}
-	errorMessage.WriteString("\n\nTry: ")
-	errorMessage.WriteString(getSuggestion(errType))
+
+	errorMessage := strings.Builder{}
+	errorMessage.WriteString(p.Sprintf("😕 Oops! %s", msg))
+	errorMessage.WriteString("\n\n")
+	errorMessage.WriteString(p.Sprintf("Try: %s", getSuggestion(p, errType)))
 	err := c.Send(errorMessage.String())
 	if err != nil {
 		slog.Error(
@@ -61,40 +65,40 @@ func sendError(c tb.Context, errType, msg string) {
 	}
 }
 
-func getDefaultMessage(errType string) string {
+func getDefaultMessage(p *message.Printer, errType string) string {
 	switch errType {
 	case "incorrect input":
-		return "The provided input format is incorrect"
+		return p.Sprintf("The provided input format is incorrect")
 	case "not found":
-		return "We couldn't find what you're looking for"
+		return p.Sprintf("We couldn't find what you're looking for")
 	case "conflict":
-		return "There's a conflict with existing data"
+		return p.Sprintf("There's a conflict with existing data")
 	case "already exists":
-		return "This item already exists"
+		return p.Sprintf("This item already exists")
 	case "authorization":
-		return "You need to be authorized for this action"
+		return p.Sprintf("You need to be authorized for this action")
 	case "forbidden":
-		return "You don't have permission for this action"
+		return p.Sprintf("You don't have permission for this action")
 	default:
-		return "Something went wrong on our end"
+		return p.Sprintf("Something went wrong on our end")
 	}
 }
 
-func getSuggestion(errType string) string {
+func getSuggestion(p *message.Printer, errType string) string {
 	switch errType {
 	case "incorrect input":
-		return "Double-check your input format and try again"
+		return p.Sprintf("Double-check your input format and try again")
 	case "not found":
-		return "Verify the ID or search term and try again"
+		return p.Sprintf("Verify the ID or search term and try again")
 	case "conflict":
-		return "Review your data and try a different value"
+		return p.Sprintf("Review your data and try a different value")
 	case "already exists":
-		return "Use a different identifier or update the existing item"
+		return p.Sprintf("Use a different identifier or update the existing item")
 	case "authorization":
-		return "Log in and try again"
+		return p.Sprintf("Log in and try again")
 	case "forbidden":
-		return "Contact an administrator for access"
+		return p.Sprintf("Contact an administrator for access")
 	default:
-		return "Please try again later or contact support"
+		return p.Sprintf("Please try again later or contact support")
 	}
 }
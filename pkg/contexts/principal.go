@@ -0,0 +1,36 @@
+package contexts
+
+import (
+	"context"
+	"slices"
+
+	"github.com/gofrs/uuid"
+)
+
+const principalKey CtxKey = "principal"
+
+// Principal is the registered user a request is authorized as.
+type Principal struct {
+	UserID uuid.UUID
+	// ChatID is the telegram id of the user.
+	ChatID   int64
+	Language string
+	// Scopes are what the request is allowed to do, read and write; the read api tokens only have read.
+	Scopes []string
+	// TokenID is the api token the request is authorized with, uuid.Nil for the tma authorization.
+	TokenID uuid.UUID
+}
+
+// HasScope reports whether the request is allowed to do what the scope grants.
+func (p Principal) HasScope(scope string) bool {
+	return slices.Contains(p.Scopes, scope)
+}
+
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(Principal)
+	return principal, ok
+}
@@ -0,0 +1,126 @@
+package safehttp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsPublic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{ip: "8.8.8.8", want: true},
+		{ip: "2606:4700:4700::1111", want: true},
+		{ip: "127.0.0.1", want: false},
+		{ip: "::1", want: false},
+		{ip: "10.1.2.3", want: false},
+		{ip: "172.16.0.1", want: false},
+		{ip: "192.168.1.1", want: false},
+		{ip: "169.254.169.254", want: false},
+		{ip: "fe80::1", want: false},
+		{ip: "fd00::1", want: false},
+		{ip: "0.0.0.0", want: false},
+		{ip: "::", want: false},
+		{ip: "100.64.0.1", want: false},
+		{ip: "::ffff:127.0.0.1", want: false},
+		{ip: "::ffff:169.254.169.254", want: false},
+		{ip: "64:ff9b::a9fe:a9fe", want: false},
+		{ip: "255.255.255.255", want: false},
+		{ip: "224.0.0.1", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.ip, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsPublic(netip.MustParseAddr(tt.ip)))
+		})
+	}
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	t.Run("Expect loopback to be refused", func(t *testing.T) {
+		_, err := get(t, NewClient(5*time.Second), server.URL)
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+	t.Run("Expect link-local to be refused", func(t *testing.T) {
+		_, err := get(t, NewClient(5*time.Second), "http://169.254.169.254/latest/meta-data/")
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+	t.Run("Expect name resolving to loopback to be refused", func(t *testing.T) {
+		_, err := get(t, NewClient(5*time.Second), "http://localhost:1/")
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+}
+
+func TestNewClient_Redirects(t *testing.T) {
+	t.Parallel()
+
+	// the test server listens on the loopback, it stands for a public server
+	loopback := netip.MustParseAddr("127.0.0.1")
+	client := newClient(5*time.Second, func(ip netip.Addr) bool { return ip == loopback })
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/to-ok", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/ok", http.StatusFound)
+	})
+	mux.HandleFunc("/to-metadata", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://169.254.169.254/latest/meta-data/", http.StatusFound)
+	})
+	mux.HandleFunc("/to-file", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "file:///etc/passwd", http.StatusFound)
+	})
+	mux.HandleFunc("/loop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/loop", http.StatusFound)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	t.Run("Expect redirect to be followed", func(t *testing.T) {
+		status, err := get(t, client, server.URL+"/to-ok")
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, status)
+	})
+	t.Run("Expect redirect to forbidden address to be refused", func(t *testing.T) {
+		_, err := get(t, client, server.URL+"/to-metadata")
+		assert.ErrorIs(t, err, ErrForbiddenAddress)
+	})
+	t.Run("Expect redirect to other scheme to be refused", func(t *testing.T) {
+		_, err := get(t, client, server.URL+"/to-file")
+		assert.ErrorContains(t, err, "unsupported scheme")
+	})
+	t.Run("Expect redirects to be limited", func(t *testing.T) {
+		_, err := get(t, client, server.URL+"/loop")
+		assert.ErrorContains(t, err, "stopped after 5 redirects")
+	})
+}
+
+func get(t *testing.T, client *http.Client, url string) (int, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, url, nil)
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	return resp.StatusCode, nil
+}
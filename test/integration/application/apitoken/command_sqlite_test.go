@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	apitokenapp "github.com/ARUMANDESU/go-revise/internal/application/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+var (
+	// userID and otherUserID are users from the mock data.
+	userID      = uuid.FromStringOrNil("e471de92-5652-46b4-94e9-5ad1766874f7")
+	otherUserID = uuid.FromStringOrNil("b0fca268-3772-407e-b446-b41ba44bf33d")
+)
+
+func TestAPIToken(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	tokenID := apitoken.NewTokenID()
+	secret, err := app.Commands.CreateToken.Handle(ctx, command.CreateToken{
+		ID:     tokenID,
+		UserID: userID,
+		Name:   "obsidian sync",
+		Scope:  apitoken.ScopeWrite,
+	})
+	require.NoError(t, err)
+
+	t.Run("Expect the token to authenticate the user", func(t *testing.T) {
+		authenticated, err := app.Commands.Authenticate.Handle(ctx, command.Authenticate{Token: secret})
+		require.NoError(t, err)
+		assert.Equal(t, command.Authenticated{TokenID: tokenID, UserID: userID, Scope: apitoken.ScopeWrite}, authenticated)
+	})
+
+	t.Run("Expect the token to be listed with its last use", func(t *testing.T) {
+		tokens, err := app.Queries.ListUserTokens.Handle(ctx, query.ListUserTokens{UserID: userID})
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, tokenID, tokens[0].ID)
+		assert.Equal(t, "obsidian sync", tokens[0].Name)
+		assert.Equal(t, "write", tokens[0].Scope)
+		assert.NotNil(t, tokens[0].LastUsedAt)
+	})
+
+	t.Run("Expect unknown tokens to be rejected", func(t *testing.T) {
+		for _, token := range []string{"", "not a token", apitoken.Prefix + "unknown"} {
+			_, err := app.Commands.Authenticate.Handle(ctx, command.Authenticate{Token: token})
+			assert.True(t, errs.IsErrorType(err, errs.ErrorTypeAuthorization), token)
+		}
+	})
+
+	t.Run("Expect other users not to revoke the token", func(t *testing.T) {
+		err := app.Commands.RevokeToken.Handle(ctx, command.RevokeToken{ID: tokenID, UserID: otherUserID})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeForbidden))
+	})
+
+	t.Run("Expect the revoked token to be rejected", func(t *testing.T) {
+		require.NoError(t, app.Commands.RevokeToken.Handle(ctx, command.RevokeToken{ID: tokenID, UserID: userID}))
+
+		_, err := app.Commands.Authenticate.Handle(ctx, command.Authenticate{Token: secret})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeAuthorization))
+
+		tokens, err := app.Queries.ListUserTokens.Handle(ctx, query.ListUserTokens{UserID: userID})
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+
+		err = app.Commands.RevokeToken.Handle(ctx, command.RevokeToken{ID: tokenID, UserID: userID})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeConflict))
+	})
+}
+
+func NewApplication(t *testing.T) apitokenapp.Application {
+	t.Helper()
+
+	repo := apitoken.NewSQLiteRepo(tester.NewSQLiteDB(t))
+	return apitokenapp.Application{
+		Commands: apitokenapp.Commands{
+			CreateToken:  command.NewCreateTokenHandler(&repo),
+			RevokeToken:  command.NewRevokeTokenHandler(&repo),
+			Authenticate: command.NewAuthenticateHandler(&repo),
+		},
+		Queries: apitokenapp.Queries{
+			ListUserTokens: query.NewListUserTokensHandler(&repo),
+		},
+	}
+}
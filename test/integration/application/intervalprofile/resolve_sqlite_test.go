@@ -0,0 +1,200 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application"
+	intervalprofileapp "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile"
+	intervalprofilecommand "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/command"
+	intervalprofilequery "github.com/ARUMANDESU/go-revise/internal/application/intervalprofile/query"
+	reviseitemapp "github.com/ARUMANDESU/go-revise/internal/application/reviseitem"
+	reviseitemcommand "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+// userID is a user from the mock data.
+var userID = uuid.FromStringOrNil("e471de92-5652-46b4-94e9-5ad1766874f7")
+
+func TestIntervalProfile_Resolution(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	vocabularyID := createProfile(t, app, "vocabulary", "1h 6h 1d")
+	articlesID := createProfile(t, app, "articles", "1w 1M")
+
+	err := app.IntervalProfile.Commands.AttachToTag.Handle(ctx, intervalprofilecommand.AttachToTag{
+		ProfileID: vocabularyID,
+		UserID:    userID,
+		Tag:       "words",
+	})
+	require.NoError(t, err, "failed to attach profile to tag")
+
+	t.Run("Expect tag profile to be applied", func(t *testing.T) {
+		item := createItem(t, app, uuid.Nil, "words")
+		assert.WithinDuration(t, time.Now().Add(time.Hour), item.NextRevisionAt, time.Minute)
+
+		nextRevisionAt, err := app.ReviseItem.Command.Review.Handle(ctx, reviseitemcommand.Review{
+			ID:     item.ID,
+			UserID: userID,
+			Grade:  valueobject.GradeGood,
+		})
+		require.NoError(t, err, "failed to review item")
+		assert.WithinDuration(t, time.Now().Add(6*time.Hour), nextRevisionAt, time.Minute)
+	})
+	t.Run("Expect item profile to win over tag profile", func(t *testing.T) {
+		item := createItem(t, app, articlesID, "words")
+		assert.WithinDuration(t, time.Now().Add(7*24*time.Hour), item.NextRevisionAt, time.Minute)
+		assert.Equal(t, articlesID, item.IntervalProfileID)
+	})
+	t.Run("Expect user intervals without profiles", func(t *testing.T) {
+		item := createItem(t, app, uuid.Nil, "go")
+		assert.WithinDuration(t, time.Now().Add(24*time.Hour), item.NextRevisionAt, time.Minute)
+	})
+	t.Run("Expect deleted profile to be detached", func(t *testing.T) {
+		item := createItem(t, app, articlesID, "go")
+
+		err := app.IntervalProfile.Commands.DeleteProfile.Handle(ctx, intervalprofilecommand.DeleteProfile{
+			ID:     articlesID,
+			UserID: userID,
+		})
+		require.NoError(t, err, "failed to delete profile")
+
+		item = getItem(t, app, item.ID)
+		assert.Equal(t, uuid.Nil, item.IntervalProfileID)
+
+		profiles, err := app.IntervalProfile.Queries.ListUserIntervalProfiles.Handle(
+			ctx,
+			intervalprofilequery.ListUserIntervalProfiles{UserID: userID},
+		)
+		require.NoError(t, err, "failed to list profiles")
+		require.Len(t, profiles, 1)
+		assert.Equal(t, []string{"words"}, profiles[0].Tags)
+	})
+}
+
+func TestIntervalProfile_DefaultAlgorithm(t *testing.T) {
+	ctx := context.Background()
+	// sm2 is the default algorithm, it starts with the first two intervals of the profile
+	app := newApplication(t, scheduler.AlgorithmSM2)
+
+	vocabularyID := createProfile(t, app, "vocabulary", "1h 6h 1d")
+	item := createItem(t, app, vocabularyID)
+
+	for _, want := range []time.Duration{time.Hour, 6 * time.Hour} {
+		nextRevisionAt, err := app.ReviseItem.Command.Review.Handle(ctx, reviseitemcommand.Review{
+			ID:     item.ID,
+			UserID: userID,
+			Grade:  valueobject.GradeGood,
+		})
+		require.NoError(t, err, "failed to review item")
+		assert.WithinDuration(t, time.Now().Add(want), nextRevisionAt, time.Minute)
+	}
+}
+
+func createProfile(t *testing.T, app application.Application, name, intervals string) uuid.UUID {
+	t.Helper()
+
+	parsed, err := valueobject.ParseReviewInterval(intervals)
+	require.NoError(t, err, "failed to parse intervals")
+
+	id := intervalprofile.NewProfileID()
+	err = app.IntervalProfile.Commands.CreateProfile.Handle(
+		context.Background(),
+		intervalprofilecommand.CreateProfile{ID: id, UserID: userID, Name: name, Intervals: parsed},
+	)
+	require.NoError(t, err, "failed to create profile")
+	return id
+}
+
+func createItem(
+	t *testing.T,
+	app application.Application,
+	profileID uuid.UUID,
+	tags ...string,
+) reviseitemquery.ReviseItem {
+	t.Helper()
+
+	id := reviseitem.NewReviseItemID()
+	err := app.ReviseItem.Command.NewReviseItem.Handle(context.Background(), reviseitemcommand.NewReviseItem{
+		ID:                id,
+		UserID:            userID,
+		Name:              "item",
+		Tags:              valueobject.NewTags(tags...),
+		IntervalProfileID: profileID,
+	})
+	require.NoError(t, err, "failed to create item")
+	return getItem(t, app, id)
+}
+
+func getItem(t *testing.T, app application.Application, id uuid.UUID) reviseitemquery.ReviseItem {
+	t.Helper()
+
+	item, err := app.ReviseItem.Query.GetReviseItem.Handle(
+		context.Background(),
+		reviseitemquery.GetReviseItem{ID: id, UserID: userID},
+	)
+	require.NoError(t, err, "failed to get item")
+	return item
+}
+
+func NewApplication(t *testing.T) application.Application {
+	t.Helper()
+	return newApplication(t, scheduler.AlgorithmLadder)
+}
+
+func newApplication(t *testing.T, algorithm scheduler.Algorithm) application.Application {
+	t.Helper()
+
+	db := tester.NewSQLiteDB(t)
+	userRepo := repository.NewSQLiteRepo(db)
+	reviseitemRepo := reviseitem.NewSQLiteRepo(db)
+	intervalProfileRepo := intervalprofile.NewSQLiteRepo(db)
+	resolver := intervalprofile.NewResolver(&intervalProfileRepo, &userRepo)
+
+	return application.Application{
+		ReviseItem: reviseitemapp.Application{
+			Query: reviseitemapp.Query{
+				GetReviseItem: reviseitemquery.NewGetReviseItemHandler(&reviseitemRepo),
+			},
+			Command: reviseitemapp.Command{
+				NewReviseItem: reviseitemcommand.NewNewReviseItemHandler(
+					&reviseitemRepo,
+					resolver,
+					&intervalProfileRepo,
+					nil,
+				),
+				Review: reviseitemcommand.NewReviewHandler(&reviseitemRepo, resolver, &userRepo, algorithm, nil),
+			},
+		},
+		IntervalProfile: intervalprofileapp.Application{
+			Commands: intervalprofileapp.Commands{
+				CreateProfile: intervalprofilecommand.NewCreateProfileHandler(&intervalProfileRepo),
+				DeleteProfile: intervalprofilecommand.NewDeleteProfileHandler(
+					&intervalProfileRepo,
+					&intervalProfileRepo,
+				),
+				AttachToTag: intervalprofilecommand.NewAttachToTagHandler(
+					&intervalProfileRepo,
+					&intervalProfileRepo,
+				),
+			},
+			Queries: intervalprofileapp.Queries{
+				ListUserIntervalProfiles: intervalprofilequery.NewListUserIntervalProfilesHandler(
+					&intervalProfileRepo,
+				),
+			},
+		},
+	}
+}
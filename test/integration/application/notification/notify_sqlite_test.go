@@ -0,0 +1,167 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/notification"
+	"github.com/ARUMANDESU/go-revise/internal/domain/outbox"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+// chatID is a mock user with a daily reminder at 21:00 UTC and overdue revise items.
+const chatID = user.TelegramID(123456789)
+
+// otherChatID is a mock user with a daily reminder at 18:45 UTC and an overdue revise item.
+const otherChatID = user.TelegramID(135792468)
+
+func TestNotifyUsers(t *testing.T) {
+	ctx := context.Background()
+	notifier := &fakeNotifier{}
+	app := NewApplication(t, tester.NewSQLiteDB(t), notification.Notifiers{user.ChannelTelegram: notifier})
+
+	// the mock users were registered just now, so the next 21:00 is missed within two days
+	now := time.Now().Add(48 * time.Hour)
+
+	t.Run("Expect missed reminder to be caught up", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, now))
+		assert.Equal(t, 1, notifier.count(chatID))
+	})
+	t.Run("Expect no duplicate on the next tick", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, now.Add(time.Minute)))
+		assert.Equal(t, 1, notifier.count(chatID))
+	})
+
+	tomorrow := now.Add(24 * time.Hour)
+	notifier.fail(chatID, errors.New("telegram is down"))
+	t.Run("Expect failed delivery not to stop the others", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, tomorrow))
+		assert.Equal(t, 1, notifier.count(chatID))
+		assert.Equal(t, 1, notifier.failedCount(chatID))
+		assert.Equal(t, 2, notifier.count(otherChatID))
+	})
+	t.Run("Expect failed delivery not to be retried before the backoff", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, tomorrow.Add(outbox.Backoff(1)/2)))
+		assert.Equal(t, 1, notifier.failedCount(chatID))
+	})
+
+	notifier.fail(chatID, nil)
+	t.Run("Expect failed delivery to be retried after the backoff", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, tomorrow.Add(outbox.Backoff(1))))
+		assert.Equal(t, 2, notifier.count(chatID))
+	})
+}
+
+func TestNotifyUsers_Channels(t *testing.T) {
+	ctx := context.Background()
+	db := tester.NewSQLiteDB(t)
+	telegram, hook := &fakeNotifier{}, &fakeNotifier{}
+	app := NewApplication(t, db, notification.Notifiers{
+		user.ChannelTelegram: telegram,
+		user.ChannelWebhook:  hook,
+	})
+
+	userRepo := repository.NewSQLiteRepo(db)
+	u, err := userRepo.GetUserByTelegramID(ctx, chatID)
+	require.NoError(t, err)
+	err = userRepo.UpdateUser(ctx, u.ID(), func(u *user.User) (*user.User, error) {
+		settings := u.Settings()
+		settings.Channels = []user.Channel{user.ChannelTelegram, user.ChannelWebhook, user.ChannelEmail}
+		settings.Email = "learner@example.com"
+		settings.WebhookURL = "https://example.com/hooks/revise"
+		settings.WebhookSecret, err = user.NewWebhookSecret()
+		require.NoError(t, err)
+		return u, u.UpdateSettings(settings)
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Add(48 * time.Hour)
+	hook.fail(chatID, errors.New("webhook is down"))
+	t.Run("Expect failed channel not to stop the others", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, now))
+		assert.Equal(t, 1, telegram.count(chatID))
+		assert.Equal(t, 1, hook.failedCount(chatID))
+	})
+
+	t.Run("Expect only the failed channel to be pending", func(t *testing.T) {
+		outboxRepo := outbox.NewSQLiteRepo(db)
+		pending, err := outboxRepo.ListPending(ctx, now.Add(outbox.Backoff(1)), 100)
+		require.NoError(t, err)
+		// the email channel has no notifier, so it is not enqueued at all
+		require.Len(t, pending, 1)
+		assert.Equal(t, user.ChannelWebhook, pending[0].Channel())
+	})
+
+	hook.fail(chatID, nil)
+	t.Run("Expect only the failed channel to be retried", func(t *testing.T) {
+		require.NoError(t, app.NotifyUsers(ctx, now.Add(outbox.Backoff(1))))
+		assert.Equal(t, 1, telegram.count(chatID))
+		assert.Equal(t, 1, hook.count(chatID))
+	})
+}
+
+func NewApplication(t *testing.T, db *sql.DB, notifiers notification.Notifiers) notification.Application {
+	t.Helper()
+
+	userRepo := repository.NewSQLiteRepo(db)
+	reviseItemRepo := reviseitem.NewSQLiteRepo(db)
+	outboxRepo := outbox.NewSQLiteRepo(db)
+
+	app := notification.NewApplication(&userRepo, &reviseItemRepo, &outboxRepo, notifiers)
+	app.CatchUp = 72 * time.Hour
+	return app
+}
+
+// fakeNotifier records the notifications sent per chat, and fails for the chats it is told to.
+type fakeNotifier struct {
+	mu       sync.Mutex
+	errs     map[user.TelegramID]error
+	calls    map[user.TelegramID]int
+	failures map[user.TelegramID]int
+}
+
+func (n *fakeNotifier) Notify(_ context.Context, u user.User, _ []reviseitem.ReviseItem) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if err := n.errs[u.ChatID()]; err != nil {
+		n.failures[u.ChatID()]++
+		return err
+	}
+	if n.calls == nil {
+		n.calls = make(map[user.TelegramID]int)
+	}
+	n.calls[u.ChatID()]++
+	return nil
+}
+
+func (n *fakeNotifier) fail(chatID user.TelegramID, err error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if n.errs == nil {
+		n.errs = make(map[user.TelegramID]error)
+		n.failures = make(map[user.TelegramID]int)
+	}
+	n.errs[chatID] = err
+}
+
+func (n *fakeNotifier) count(chatID user.TelegramID) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.calls[chatID]
+}
+
+func (n *fakeNotifier) failedCount(chatID user.TelegramID) int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.failures[chatID]
+}
@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/outbox"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+// userID is the mock user with chatID.
+var userID = uuid.FromStringOrNil("e471de92-5652-46b4-94e9-5ad1766874f7")
+
+func TestOutboxSQLiteRepo(t *testing.T) {
+	ctx := context.Background()
+	repo := outbox.NewSQLiteRepo(tester.NewSQLiteDB(t))
+
+	now := time.Now()
+	reminderAt := now.Add(-time.Minute).Truncate(time.Minute)
+	delivery := outbox.NewDelivery(userID, user.ChannelTelegram, reminderAt, now)
+
+	t.Run("Expect the delivery to be enqueued", func(t *testing.T) {
+		enqueued, err := repo.Enqueue(ctx, []outbox.Delivery{delivery})
+		require.NoError(t, err)
+		assert.True(t, enqueued)
+	})
+	t.Run("Expect the same reminder not to be enqueued twice", func(t *testing.T) {
+		duplicate := outbox.NewDelivery(userID, user.ChannelTelegram, reminderAt, now)
+		enqueued, err := repo.Enqueue(ctx, []outbox.Delivery{duplicate})
+		require.NoError(t, err)
+		assert.False(t, enqueued)
+	})
+	t.Run("Expect an earlier reminder not to be enqueued", func(t *testing.T) {
+		earlier := outbox.NewDelivery(userID, user.ChannelTelegram, reminderAt.Add(-time.Hour), now)
+		enqueued, err := repo.Enqueue(ctx, []outbox.Delivery{earlier})
+		require.NoError(t, err)
+		assert.False(t, enqueued)
+	})
+
+	t.Run("Expect the delivery to be pending", func(t *testing.T) {
+		pending, err := repo.ListPending(ctx, now, 10)
+		require.NoError(t, err)
+		require.Len(t, pending, 1)
+		assert.Equal(t, delivery.ID(), pending[0].ID())
+		assert.True(t, reminderAt.Equal(pending[0].ReminderAt()))
+	})
+
+	delivery.MarkFailed(errors.New("chat not found"), now)
+	require.NoError(t, repo.Update(ctx, delivery))
+
+	t.Run("Expect the failed delivery to wait for the backoff", func(t *testing.T) {
+		pending, err := repo.ListPending(ctx, now, 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		pending, err = repo.ListPending(ctx, delivery.NextAttemptAt(), 10)
+		require.NoError(t, err)
+		assert.Len(t, pending, 1)
+	})
+	t.Run("Expect the attempt to be tracked", func(t *testing.T) {
+		got, err := repo.Get(ctx, delivery.ID())
+		require.NoError(t, err)
+		assert.Equal(t, outbox.StatusPending, got.Status())
+		assert.Equal(t, 1, got.Attempts())
+		assert.Equal(t, "chat not found", got.LastError())
+	})
+
+	delivery.MarkSent(now)
+	require.NoError(t, repo.Update(ctx, delivery))
+
+	t.Run("Expect the sent delivery not to be pending", func(t *testing.T) {
+		pending, err := repo.ListPending(ctx, now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		assert.Empty(t, pending)
+
+		got, err := repo.Get(ctx, delivery.ID())
+		require.NoError(t, err)
+		assert.Equal(t, outbox.StatusSent, got.Status())
+		assert.False(t, got.SentAt().IsZero())
+	})
+}
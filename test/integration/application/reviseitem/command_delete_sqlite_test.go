@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+func TestDeleteAndRestoreReviseItem(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+	foreignUserID := uuid.FromStringOrNil("b0fca268-3772-407e-b446-b41ba44bf33d")
+
+	listItemIDs := func(t *testing.T) []uuid.UUID {
+		t.Helper()
+		items, _, err := app.ReviseItem.Query.ListUserReviseItems.Handle(
+			ctx,
+			query.ListUserReviseItems{UserID: userID},
+		)
+		require.NoError(t, err)
+		ids := make([]uuid.UUID, 0, len(items))
+		for _, item := range items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	t.Run("Expect foreign user not to delete the item", func(t *testing.T) {
+		err := app.ReviseItem.Command.DeleteReviseItem.Handle(
+			ctx,
+			command.DeleteReviseItem{ID: legacyItemID, UserID: foreignUserID},
+		)
+		require.Error(t, err)
+		assert.Contains(t, listItemIDs(t), legacyItemID)
+	})
+
+	t.Run("Expect restoring an item that is not deleted to fail", func(t *testing.T) {
+		err := app.ReviseItem.Command.RestoreReviseItem.Handle(
+			ctx,
+			command.RestoreReviseItem{ID: legacyItemID, UserID: userID},
+		)
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeNotFound))
+	})
+
+	t.Run("Expect deleted item to be hidden", func(t *testing.T) {
+		err := app.ReviseItem.Command.DeleteReviseItem.Handle(
+			ctx,
+			command.DeleteReviseItem{ID: legacyItemID, UserID: userID},
+		)
+		require.NoError(t, err)
+
+		assert.NotContains(t, listItemIDs(t), legacyItemID)
+		_, err = app.ReviseItem.Query.GetReviseItem.Handle(ctx, query.GetReviseItem{ID: legacyItemID, UserID: userID})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeNotFound))
+	})
+
+	t.Run("Expect foreign user not to restore the item", func(t *testing.T) {
+		err := app.ReviseItem.Command.RestoreReviseItem.Handle(
+			ctx,
+			command.RestoreReviseItem{ID: legacyItemID, UserID: foreignUserID},
+		)
+		require.Error(t, err)
+		assert.NotContains(t, listItemIDs(t), legacyItemID)
+	})
+
+	t.Run("Expect restored item to be back", func(t *testing.T) {
+		err := app.ReviseItem.Command.RestoreReviseItem.Handle(
+			ctx,
+			command.RestoreReviseItem{ID: legacyItemID, UserID: userID},
+		)
+		require.NoError(t, err)
+
+		assert.Contains(t, listItemIDs(t), legacyItemID)
+		item, err := app.ReviseItem.Query.GetReviseItem.Handle(ctx, query.GetReviseItem{ID: legacyItemID, UserID: userID})
+		require.NoError(t, err)
+		assert.Nil(t, item.DeletedAt)
+		assert.Len(t, item.Revisions, 2, "restore must keep the revisions")
+	})
+}
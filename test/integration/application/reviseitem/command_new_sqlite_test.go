@@ -0,0 +1,74 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+// titleFetcherStub returns the titles of the known pages and fails for the others.
+type titleFetcherStub map[string]string
+
+func (s titleFetcherStub) Title(_ context.Context, url string) (string, error) {
+	title, ok := s[url]
+	if !ok {
+		return "", errs.NewNotFound("test.title_fetcher_stub", errs.ErrInvalidInput, "page not found")
+	}
+	return title, nil
+}
+
+func TestNewReviseItem_Source(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	tests := []struct {
+		name     string
+		cmd      command.NewReviseItem
+		wantName string
+	}{
+		{
+			name:     "With name and source",
+			cmd:      command.NewReviseItem{Name: "Memory model", Source: "https://go.dev/ref/mem"},
+			wantName: "Memory model",
+		},
+		{
+			name:     "With source only",
+			cmd:      command.NewReviseItem{Source: "https://go.dev/ref/mem"},
+			wantName: "The Go Memory Model",
+		},
+		{
+			name:     "With source of unknown title",
+			cmd:      command.NewReviseItem{Source: "https://example.com/post"},
+			wantName: "https://example.com/post",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.cmd.ID = reviseitem.NewReviseItemID()
+			tt.cmd.UserID = userID
+			require.NoError(t, app.ReviseItem.Command.NewReviseItem.Handle(ctx, tt.cmd))
+
+			item, err := app.ReviseItem.Query.GetReviseItem.Handle(ctx, query.GetReviseItem{ID: tt.cmd.ID, UserID: userID})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantName, item.Name)
+			assert.Equal(t, tt.cmd.Source, item.Source)
+		})
+	}
+
+	t.Run("Expect invalid source to fail", func(t *testing.T) {
+		err := app.ReviseItem.Command.NewReviseItem.Handle(ctx, command.NewReviseItem{
+			ID:     reviseitem.NewReviseItemID(),
+			UserID: userID,
+			Source: "not a link",
+		})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+	})
+}
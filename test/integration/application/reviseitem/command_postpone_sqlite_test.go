@@ -0,0 +1,86 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+)
+
+func TestPostpone(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+	until := time.Now().Add(72 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name        string
+		cmd         command.Postpone
+		want        time.Time
+		errExpected bool
+	}{
+		{
+			name: "With duration on overdue item",
+			cmd:  command.Postpone{ID: legacyItemID, UserID: userID, Duration: time.Hour},
+			want: time.Now().Add(time.Hour),
+		},
+		{
+			name: "With duration on item due in the future",
+			cmd:  command.Postpone{ID: legacyItemID, UserID: userID, Duration: time.Hour},
+			want: time.Now().Add(2 * time.Hour),
+		},
+		{
+			name: "With until",
+			cmd:  command.Postpone{ID: legacyItemID, UserID: userID, Until: until},
+			want: until,
+		},
+		{
+			name:        "With until in the past",
+			cmd:         command.Postpone{ID: legacyItemID, UserID: userID, Until: time.Now().Add(-time.Hour)},
+			errExpected: true,
+		},
+		{
+			name:        "With both duration and until",
+			cmd:         command.Postpone{ID: legacyItemID, UserID: userID, Duration: time.Hour, Until: until},
+			errExpected: true,
+		},
+		{
+			name: "With foreign user",
+			cmd: command.Postpone{
+				ID:       legacyItemID,
+				UserID:   uuid.FromStringOrNil("b0fca268-3772-407e-b446-b41ba44bf33d"),
+				Duration: time.Hour,
+			},
+			errExpected: true,
+		},
+	}
+
+	before, err := app.ReviseItem.Query.GetReviseItem.Handle(ctx, query.GetReviseItem{ID: legacyItemID, UserID: userID})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextRevisionAt, err := app.ReviseItem.Command.Postpone.Handle(ctx, tt.cmd)
+			if tt.errExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.WithinDuration(t, tt.want, nextRevisionAt, time.Minute)
+
+			item, err := app.ReviseItem.Query.GetReviseItem.Handle(
+				ctx,
+				query.GetReviseItem{ID: legacyItemID, UserID: userID},
+			)
+			require.NoError(t, err)
+			assert.True(t, nextRevisionAt.Equal(item.NextRevisionAt), "next revision must be persisted")
+			assert.True(t, before.LastRevisedAt.Equal(item.LastRevisedAt), "postpone must not count as a revision")
+			assert.Len(t, item.Revisions, len(before.Revisions))
+		})
+	}
+}
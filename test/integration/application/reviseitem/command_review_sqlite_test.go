@@ -0,0 +1,254 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application"
+	reviseitemapp "github.com/ARUMANDESU/go-revise/internal/application/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	userapp "github.com/ARUMANDESU/go-revise/internal/application/user"
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+var (
+	// userID is a user from the mock data.
+	userID = uuid.FromStringOrNil("e471de92-5652-46b4-94e9-5ad1766874f7")
+	// legacyItemID is an item of userID with two revisions made before grade-based scheduling.
+	legacyItemID = uuid.FromStringOrNil("d7accc08-981f-4aa7-8477-b1840b9a2611")
+)
+
+func TestReview(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+	intervals := valueobject.DefaultReviewIntervals()
+
+	tests := []struct {
+		name          string
+		cmd           command.Review
+		wantInterval  time.Duration
+		wantRevisions int
+		errExpected   bool
+	}{
+		{
+			name:          "With legacy item",
+			cmd:           command.Review{ID: legacyItemID, UserID: userID, Grade: valueobject.GradeGood},
+			wantInterval:  intervals.At(3),
+			wantRevisions: 3,
+		},
+		{
+			name:          "With reviewed item",
+			cmd:           command.Review{ID: legacyItemID, UserID: userID, Grade: valueobject.GradeGood},
+			wantInterval:  intervals.At(4),
+			wantRevisions: 4,
+		},
+		{
+			name:          "With again grade",
+			cmd:           command.Review{ID: legacyItemID, UserID: userID, Grade: valueobject.GradeAgain},
+			wantInterval:  intervals.At(0),
+			wantRevisions: 5,
+		},
+		{
+			name: "With foreign user",
+			cmd: command.Review{
+				ID:     legacyItemID,
+				UserID: uuid.FromStringOrNil("b0fca268-3772-407e-b446-b41ba44bf33d"),
+				Grade:  valueobject.GradeGood,
+			},
+			wantRevisions: 5,
+			errExpected:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nextRevisionAt, err := app.ReviseItem.Command.Review.Handle(ctx, tt.cmd)
+			if tt.errExpected {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.WithinDuration(t, time.Now().Add(tt.wantInterval), nextRevisionAt, time.Minute)
+			}
+
+			item, err := app.ReviseItem.Query.GetReviseItem.Handle(
+				ctx,
+				query.GetReviseItem{ID: legacyItemID, UserID: userID},
+			)
+			require.NoError(t, err)
+			assert.Len(t, item.Revisions, tt.wantRevisions)
+			if !tt.errExpected {
+				assert.True(t, nextRevisionAt.Equal(item.NextRevisionAt), "next revision must be persisted")
+			}
+		})
+	}
+}
+
+func NewApplication(t *testing.T) application.Application {
+	t.Helper()
+	return newApplication(t, scheduler.AlgorithmLadder)
+}
+
+func newApplication(t *testing.T, algorithm scheduler.Algorithm) application.Application {
+	t.Helper()
+
+	db := tester.NewSQLiteDB(t)
+	userRepo := repository.NewSQLiteRepo(db)
+	reviseitemRepo := reviseitem.NewSQLiteRepo(db)
+	intervalProfileRepo := intervalprofile.NewSQLiteRepo(db)
+	resolver := intervalprofile.NewResolver(&intervalProfileRepo, &userRepo)
+
+	return application.Application{
+		User: userapp.Application{
+			Commands: userapp.Commands{
+				ChangeSettings: usercmd.NewChangeSettingsHandler(&userRepo, &userRepo),
+			},
+		},
+		ReviseItem: reviseitemapp.Application{
+			Query: reviseitemapp.Query{
+				GetReviseItem:         query.NewGetReviseItemHandler(&reviseitemRepo),
+				ListUserReviseItems:   query.NewListUserReviseItemsHandler(&reviseitemRepo),
+				ListDueReviseItems:    query.NewListDueReviseItemsHandler(&reviseitemRepo, &userRepo, &userRepo),
+				ListUserTags:          query.NewListUserTagsHandler(&reviseitemRepo),
+				SearchUserReviseItems: query.NewSearchUserReviseItemsHandler(&reviseitemRepo),
+			},
+			Command: reviseitemapp.Command{
+				NewReviseItem: command.NewNewReviseItemHandler(
+					&reviseitemRepo,
+					resolver,
+					&intervalProfileRepo,
+					titleFetcherStub{"https://go.dev/ref/mem": "The Go Memory Model"},
+				),
+				DeleteReviseItem:  command.NewDeleteReviseItemHandler(&reviseitemRepo),
+				RestoreReviseItem: command.NewRestoreReviseItemHandler(&reviseitemRepo),
+				Review:            command.NewReviewHandler(&reviseitemRepo, resolver, &userRepo, algorithm, nil),
+				Postpone:          command.NewPostponeHandler(&reviseitemRepo),
+				ResetProgress:     command.NewResetProgressHandler(&reviseitemRepo, resolver),
+			},
+		},
+	}
+}
+
+func TestReview_DefaultAlgorithm(t *testing.T) {
+	ctx := context.Background()
+	// sm2 is the default algorithm, it starts with the first two intervals of the user
+	app := newApplication(t, scheduler.AlgorithmSM2)
+
+	intervals, err := valueobject.ParseReviewInterval("2d 5d")
+	require.NoError(t, err)
+	err = app.User.Commands.ChangeSettings.Handle(ctx, usercmd.ChangeSettings{ID: userID, ReviewIntervals: &intervals})
+	require.NoError(t, err)
+
+	id := reviseitem.NewReviseItemID()
+	err = app.ReviseItem.Command.NewReviseItem.Handle(ctx, command.NewReviseItem{ID: id, UserID: userID, Name: "SM-2"})
+	require.NoError(t, err)
+
+	for _, want := range []time.Duration{2 * 24 * time.Hour, 5 * 24 * time.Hour} {
+		nextRevisionAt, err := app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+			ID:     id,
+			UserID: userID,
+			Grade:  valueobject.GradeGood,
+		})
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(want), nextRevisionAt, time.Minute)
+	}
+}
+
+func TestReview_Journal(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	_, err := app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+		ID:         legacyItemID,
+		UserID:     userID,
+		Grade:      valueobject.GradeHard,
+		Notes:      "forgot the order of operations",
+		Duration:   90 * time.Second,
+		Confidence: 2,
+	})
+	require.NoError(t, err)
+
+	item, err := app.ReviseItem.Query.GetReviseItem.Handle(
+		ctx,
+		query.GetReviseItem{ID: legacyItemID, UserID: userID},
+	)
+	require.NoError(t, err)
+	require.Len(t, item.Revisions, 3)
+
+	legacy := item.Revisions[0]
+	assert.Equal(t, valueobject.Grade(0), legacy.Grade)
+	assert.Empty(t, legacy.Notes)
+	assert.Zero(t, legacy.Duration)
+	assert.Equal(t, valueobject.ConfidenceNotRated, legacy.Confidence)
+
+	last := item.Revisions[2]
+	assert.Equal(t, valueobject.GradeHard, last.Grade)
+	assert.Equal(t, "forgot the order of operations", last.Notes)
+	assert.Equal(t, 90*time.Second, last.Duration)
+	assert.Equal(t, valueobject.Confidence(2), last.Confidence)
+}
+
+func TestReview_RelapseAndReset(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+	intervals := valueobject.DefaultReviewIntervals()
+
+	// two legacy revisions, a good review moves the item to the fourth interval
+	_, err := app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+		ID:     legacyItemID,
+		UserID: userID,
+		Grade:  valueobject.GradeGood,
+	})
+	require.NoError(t, err)
+
+	nextRevisionAt, err := app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+		ID:      legacyItemID,
+		UserID:  userID,
+		Relapse: 2,
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(intervals.At(1)), nextRevisionAt, time.Minute)
+
+	nextRevisionAt, err = app.ReviseItem.Command.ResetProgress.Handle(ctx, command.ResetProgress{
+		ID:     legacyItemID,
+		UserID: userID,
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(intervals.At(0)), nextRevisionAt, time.Minute)
+
+	nextRevisionAt, err = app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+		ID:     legacyItemID,
+		UserID: userID,
+		Grade:  valueobject.GradeGood,
+	})
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(intervals.At(1)), nextRevisionAt, time.Minute)
+
+	item, err := app.ReviseItem.Query.GetReviseItem.Handle(
+		ctx,
+		query.GetReviseItem{ID: legacyItemID, UserID: userID},
+	)
+	require.NoError(t, err)
+	require.Len(t, item.Revisions, 6, "history must be kept")
+
+	kinds := make([]string, 0, len(item.Revisions))
+	lapses := make([]bool, 0, len(item.Revisions))
+	for _, rev := range item.Revisions {
+		kinds = append(kinds, rev.Kind)
+		lapses = append(lapses, rev.Lapse)
+	}
+	assert.Equal(t, []string{"review", "review", "review", "relapse", "reset", "review"}, kinds)
+	assert.Equal(t, []bool{false, false, false, true, true, false}, lapses)
+	assert.Equal(t, valueobject.GradeAgain, item.Revisions[3].Grade)
+}
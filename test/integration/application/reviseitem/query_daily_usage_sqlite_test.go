@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/revision"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+func TestGetUserDailyUsage(t *testing.T) {
+	db := tester.NewSQLiteDB(t)
+	repo := reviseitem.NewSQLiteRepo(db)
+	tz, err := valueobject.ParseTimezone("Asia/Almaty")
+	require.NoError(t, err)
+
+	// 01:00 in Almaty is still the previous day in UTC
+	revisedAt := time.Date(2026, 10, 17, 1, 0, 0, 0, tz.Location())
+	_, err = db.Exec(
+		"INSERT INTO revisions (id, revise_item_id, revised_at, grade) VALUES (?, ?, ?, ?)",
+		revision.NewRevisionID().String(), legacyItemID.String(), revisedAt.UTC(), valueobject.GradeGood,
+	)
+	require.NoError(t, err)
+
+	t.Run("Expect revision of the local day", func(t *testing.T) {
+		dayStart, _ := tz.DayBounds(revisedAt)
+		usage, err := repo.GetUserDailyUsage(context.Background(), userID, dayStart)
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.DailyUsage{Reviews: 1}, usage)
+	})
+
+	t.Run("Expect revision of the previous local day", func(t *testing.T) {
+		dayStart, _ := tz.DayBounds(revisedAt.AddDate(0, 0, 1))
+		usage, err := repo.GetUserDailyUsage(context.Background(), userID, dayStart)
+		require.NoError(t, err)
+		assert.Equal(t, valueobject.DailyUsage{}, usage)
+	})
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+func TestGetUserDueCounts(t *testing.T) {
+	repo := reviseitem.NewSQLiteRepo(tester.NewSQLiteDB(t))
+	from := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	honolulu, err := valueobject.ParseTimezone("Pacific/Honolulu")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		from      time.Time
+		excludeID uuid.UUID
+		tz        valueobject.Timezone
+		// want are the counts keyed by the local dates in the time zone
+		want map[string]int
+	}{
+		{
+			name: "With all user items",
+			from: from,
+			tz:   valueobject.UTC(),
+			want: map[string]int{"2024-11-01": 1, "2024-11-02": 1},
+		},
+		{
+			name:      "With excluded item",
+			from:      from,
+			excludeID: legacyItemID,
+			tz:        valueobject.UTC(),
+			want:      map[string]int{"2024-11-02": 1},
+		},
+		{
+			name: "With items due before from",
+			from: time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC),
+			tz:   valueobject.UTC(),
+			want: map[string]int{"2024-11-02": 1},
+		},
+		{
+			// the items are due at 08:00 and 09:00 UTC, which is the evening before in Honolulu
+			name: "With user time zone",
+			from: from,
+			tz:   honolulu,
+			want: map[string]int{"2024-10-31": 1, "2024-11-01": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetUserDueCounts(context.Background(), userID, tt.from, tt.excludeID, tt.tz)
+			require.NoError(t, err)
+			assert.Equal(t, len(tt.want), got.Days())
+			for date, count := range tt.want {
+				// the middle of the local day
+				dueAt, err := time.ParseInLocation(time.DateOnly, date, tt.tz.Location())
+				require.NoError(t, err)
+				assert.Equal(t, count, got.On(dueAt.Add(12*time.Hour)), date)
+			}
+		})
+	}
+}
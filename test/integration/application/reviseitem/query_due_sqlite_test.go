@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+)
+
+// physicsItemID is an item of userID due a day after legacyItemID.
+var physicsItemID = uuid.FromStringOrNil("e6ff2ac2-f4d1-4fcf-ae41-5509291dd799")
+
+func TestListDueReviseItems(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	listDue := func(t *testing.T) query.DueReviseItems {
+		t.Helper()
+		due, err := app.ReviseItem.Query.ListDueReviseItems.Handle(ctx, query.ListDueReviseItems{UserID: userID})
+		require.NoError(t, err)
+		return due
+	}
+	itemIDs := func(due query.DueReviseItems) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(due.Items))
+		for _, item := range due.Items {
+			ids = append(ids, item.ID)
+		}
+		return ids
+	}
+
+	t.Run("Expect all due items without quota", func(t *testing.T) {
+		due := listDue(t)
+		assert.Equal(t, []uuid.UUID{legacyItemID, physicsItemID}, itemIDs(due))
+		assert.Zero(t, due.CarriedOver)
+	})
+
+	limit := 1
+	err := app.User.Commands.ChangeSettings.Handle(ctx, usercmd.ChangeSettings{
+		ChatID:           user.TelegramID(123456789),
+		DailyReviewLimit: &limit,
+	})
+	require.NoError(t, err)
+
+	t.Run("Expect the most overdue item within quota", func(t *testing.T) {
+		due := listDue(t)
+		assert.Equal(t, []uuid.UUID{legacyItemID}, itemIDs(due))
+		assert.Equal(t, 1, due.CarriedOver)
+	})
+
+	_, err = app.ReviseItem.Command.Review.Handle(ctx, command.Review{
+		ID:     legacyItemID,
+		UserID: userID,
+		Grade:  valueobject.GradeGood,
+	})
+	require.NoError(t, err)
+
+	t.Run("Expect the rest carried over when quota is used", func(t *testing.T) {
+		due := listDue(t)
+		assert.Empty(t, due.Items)
+		assert.Equal(t, 1, due.CarriedOver)
+	})
+}
+
+func TestListDueReviseItems_DueBy(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	_, err := app.ReviseItem.Command.Postpone.Handle(ctx, command.Postpone{
+		ID:       physicsItemID,
+		UserID:   userID,
+		Duration: time.Hour,
+	})
+	require.NoError(t, err)
+
+	due, err := app.ReviseItem.Query.ListDueReviseItems.Handle(ctx, query.ListDueReviseItems{
+		UserID: userID,
+		DueBy:  time.Now(),
+	})
+	require.NoError(t, err)
+	require.Len(t, due.Items, 1)
+	assert.Equal(t, legacyItemID, due.Items[0].ID)
+}
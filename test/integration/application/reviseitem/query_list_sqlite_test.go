@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+)
+
+func TestListUserReviseItems(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	tests := []struct {
+		name       string
+		pagination query.Pagination
+		wantIDs    []uuid.UUID
+		wantLast   int
+	}{
+		{
+			name:     "With default pagination",
+			wantIDs:  []uuid.UUID{legacyItemID, physicsItemID},
+			wantLast: 1,
+		},
+		{
+			name:       "With first page",
+			pagination: query.Pagination{Page: 1, PageSize: 1},
+			wantIDs:    []uuid.UUID{legacyItemID},
+			wantLast:   2,
+		},
+		{
+			name:       "With second page",
+			pagination: query.Pagination{Page: 2, PageSize: 1},
+			wantIDs:    []uuid.UUID{physicsItemID},
+			wantLast:   2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, meta, err := app.ReviseItem.Query.ListUserReviseItems.Handle(
+				ctx,
+				query.ListUserReviseItems{UserID: userID, Pagination: tt.pagination},
+			)
+			require.NoError(t, err)
+
+			ids := make([]uuid.UUID, 0, len(items))
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			assert.ElementsMatch(t, tt.wantIDs, ids)
+			assert.Equal(t, 2, meta.TotalRecords)
+			assert.Equal(t, tt.wantLast, meta.LastPage)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/errs"
+)
+
+func TestSearchUserReviseItems(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	tests := []struct {
+		name      string
+		text      string
+		wantIDs   []uuid.UUID
+		wantTotal int
+	}{
+		{name: "With empty text", text: "", wantIDs: []uuid.UUID{legacyItemID, physicsItemID}, wantTotal: 2},
+		{name: "With name", text: "math", wantIDs: []uuid.UUID{legacyItemID}, wantTotal: 1},
+		{name: "With description", text: "introductory", wantIDs: []uuid.UUID{physicsItemID}, wantTotal: 1},
+		{name: "With tag", text: "fundamentals", wantIDs: []uuid.UUID{physicsItemID}, wantTotal: 1},
+		{name: "With item of other user", text: "grammar", wantIDs: nil},
+		{name: "With wildcard", text: "%", wantIDs: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, meta, err := app.ReviseItem.Query.SearchUserReviseItems.Handle(ctx, query.SearchUserReviseItems{
+				UserID: userID,
+				Text:   tt.text,
+			})
+			require.NoError(t, err)
+
+			var ids []uuid.UUID
+			for _, item := range items {
+				ids = append(ids, item.ID)
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantTotal, meta.TotalRecords)
+		})
+	}
+
+	t.Run("Expect pages", func(t *testing.T) {
+		items, meta, err := app.ReviseItem.Query.SearchUserReviseItems.Handle(ctx, query.SearchUserReviseItems{
+			UserID:     userID,
+			Pagination: query.Pagination{Page: 2, PageSize: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, physicsItemID, items[0].ID)
+		assert.Equal(t, 2, meta.LastPage)
+	})
+
+	t.Run("Expect name matches first", func(t *testing.T) {
+		// the new item is due later than the physics one matching by description
+		cmd := command.NewReviseItem{ID: reviseitem.NewReviseItemID(), UserID: userID, Name: "Introductory notes"}
+		require.NoError(t, app.ReviseItem.Command.NewReviseItem.Handle(ctx, cmd))
+
+		items, _, err := app.ReviseItem.Query.SearchUserReviseItems.Handle(ctx, query.SearchUserReviseItems{
+			UserID: userID,
+			Text:   "introductory",
+		})
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, cmd.ID, items[0].ID)
+		assert.Equal(t, physicsItemID, items[1].ID)
+	})
+
+	t.Run("Expect case insensitive non-ASCII text", func(t *testing.T) {
+		physics := command.NewReviseItem{
+			ID:     reviseitem.NewReviseItemID(),
+			UserID: userID,
+			Name:   "Физика",
+			Tags:   valueobject.NewTags("Механика"),
+		}
+		require.NoError(t, app.ReviseItem.Command.NewReviseItem.Handle(ctx, physics))
+		kazakh := command.NewReviseItem{ID: reviseitem.NewReviseItemID(), UserID: userID, Name: "қазақ тілі"}
+		require.NoError(t, app.ReviseItem.Command.NewReviseItem.Handle(ctx, kazakh))
+
+		for text, wantID := range map[string]uuid.UUID{
+			"физика":     physics.ID,
+			"ФИЗИКА":     physics.ID,
+			"механика":   physics.ID,
+			"Қазақ":      kazakh.ID,
+			"ҚАЗАҚ ТІЛІ": kazakh.ID,
+		} {
+			items, _, err := app.ReviseItem.Query.SearchUserReviseItems.Handle(ctx, query.SearchUserReviseItems{
+				UserID: userID,
+				Text:   text,
+			})
+			require.NoError(t, err)
+			require.Len(t, items, 1, text)
+			assert.Equal(t, wantID, items[0].ID, text)
+		}
+	})
+
+	t.Run("Expect nil user to fail", func(t *testing.T) {
+		_, _, err := app.ReviseItem.Query.SearchUserReviseItems.Handle(ctx, query.SearchUserReviseItems{Text: "math"})
+		assert.True(t, errs.IsErrorType(err, errs.ErrorTypeIncorrectInput))
+	})
+}
+
+func TestGetReviseItem_OtherUser(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	_, err := app.ReviseItem.Query.GetReviseItem.Handle(ctx, query.GetReviseItem{
+		ID:     legacyItemID,
+		UserID: uuid.FromStringOrNil("b0fca268-3772-407e-b446-b41ba44bf33d"),
+	})
+	assert.True(t, errs.IsErrorType(err, errs.ErrorTypeNotFound))
+}
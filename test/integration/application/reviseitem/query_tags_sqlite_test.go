@@ -0,0 +1,41 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	"github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+)
+
+func TestListUserTags(t *testing.T) {
+	ctx := context.Background()
+	app := NewApplication(t)
+
+	t.Run("Expect tags of the user only", func(t *testing.T) {
+		tags, err := app.ReviseItem.Query.ListUserTags.Handle(ctx, query.ListUserTags{UserID: userID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"basics", "fundamentals", "math", "physics"}, tags)
+	})
+
+	t.Run("Expect limited tags", func(t *testing.T) {
+		tags, err := app.ReviseItem.Query.ListUserTags.Handle(ctx, query.ListUserTags{UserID: userID, Limit: 2})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"basics", "fundamentals"}, tags)
+	})
+
+	t.Run("Expect tags of deleted items to be left out", func(t *testing.T) {
+		err := app.ReviseItem.Command.DeleteReviseItem.Handle(
+			ctx,
+			command.DeleteReviseItem{ID: legacyItemID, UserID: userID},
+		)
+		require.NoError(t, err)
+
+		tags, err := app.ReviseItem.Query.ListUserTags.Handle(ctx, query.ListUserTags{UserID: userID})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"fundamentals", "physics"}, tags)
+	})
+}
@@ -64,12 +64,12 @@ func TestUserApp_RegisterUser(t *testing.T) {
 			require.NoError(t, err, "failed to get user")
 
 			assert.Equal(t, tt.cmd.ChatID, user.TelegramID(queryUser.ChatID))
-			assert.Equal(t, tt.cmd.Settings.ReminderTime.Hour, queryUser.Settings.ReminderTime.Hour)
-			assert.Equal(
-				t,
-				tt.cmd.Settings.ReminderTime.Minute,
-				queryUser.Settings.ReminderTime.Minute,
-			)
+			require.Len(t, queryUser.Settings.ReminderSlots, len(tt.cmd.Settings.ReminderSlots))
+			for i, slot := range tt.cmd.Settings.ReminderSlots {
+				assert.Equal(t, slot.Time.Hour, queryUser.Settings.ReminderSlots[i].Hour)
+				assert.Equal(t, slot.Time.Minute, queryUser.Settings.ReminderSlots[i].Minute)
+				assert.Equal(t, slot.Weekdays.String(), queryUser.Settings.ReminderSlots[i].Weekdays)
+			}
 		})
 	}
 }
@@ -0,0 +1,94 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/domain/user"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
+	"github.com/ARUMANDESU/go-revise/internal/domain/valueobject"
+	"github.com/ARUMANDESU/go-revise/pkg/pointers"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+func TestSQLiteRepo_GetUsersForNotification(t *testing.T) {
+	ctx := context.Background()
+	userRepo := repository.NewSQLiteRepo(tester.NewSQLiteDB(t))
+
+	almaty, err := valueobject.ParseTimezone("Asia/Almaty")
+	require.NoError(t, err)
+
+	register := func(chatID user.TelegramID, slots ...user.ReminderSlot) *user.User {
+		t.Helper()
+		settings, err := user.NewSettings(
+			pointers.New(user.DefaultLanguage()),
+			user.DefaultReminderTime(),
+			user.WithTimezone(almaty),
+			user.WithReminderSlots(slots...),
+		)
+		require.NoError(t, err)
+		u, err := user.NewUser(uuid.Must(uuid.NewV7()), chatID, user.WithSettings(settings))
+		require.NoError(t, err)
+		require.NoError(t, userRepo.CreateUser(ctx, *u))
+		return u
+	}
+	withSlots := register(
+		user.TelegramID(1001),
+		user.DefaultReminderSlot(),
+		user.ReminderSlot{Time: user.ReminderTime{Hour: 21}, Weekdays: user.Workdays},
+	)
+	withoutSlots := register(user.TelegramID(1002))
+
+	users, err := userRepo.GetUsersForNotification(ctx)
+	require.NoError(t, err)
+	byID := make(map[uuid.UUID]user.User, len(users))
+	for _, u := range users {
+		byID[u.ID()] = u
+	}
+
+	t.Run("Expect the user with reminder slots", func(t *testing.T) {
+		require.Contains(t, byID, withSlots.ID())
+		got := byID[withSlots.ID()]
+		assert.Equal(t, withSlots.Settings().ReminderSlots, got.Settings().ReminderSlots)
+		assert.Equal(t, "Asia/Almaty", got.Settings().Timezone.String())
+		assert.True(t, got.LastNotifiedAt().IsZero())
+	})
+	t.Run("Expect no user without reminder slots", func(t *testing.T) {
+		assert.NotContains(t, byID, withoutSlots.ID())
+	})
+}
+
+func TestSQLiteRepo_UpdateUser_ReminderSlots(t *testing.T) {
+	ctx := context.Background()
+	userRepo := repository.NewSQLiteRepo(tester.NewSQLiteDB(t))
+
+	u := user.MustNewUser(uuid.Must(uuid.NewV7()), user.TelegramID(2001))
+	require.NoError(t, userRepo.CreateUser(ctx, *u))
+
+	evening, err := user.ParseReminderSlot("21:00@workdays")
+	require.NoError(t, err)
+	quietHours, err := user.ParseQuietHours("22:00-07:00")
+	require.NoError(t, err)
+
+	err = userRepo.UpdateUser(ctx, u.ID(), func(u *user.User) (*user.User, error) {
+		settings := u.Settings()
+		settings.ReminderSlots = []user.ReminderSlot{evening}
+		settings.QuietHours = quietHours
+		return u, u.UpdateSettings(settings)
+	})
+	require.NoError(t, err)
+
+	got, err := userRepo.GetUserByTelegramID(ctx, u.ChatID())
+	require.NoError(t, err)
+
+	t.Run("Expect reminder slots replaced", func(t *testing.T) {
+		assert.Equal(t, []user.ReminderSlot{evening}, got.Settings().ReminderSlots)
+	})
+	t.Run("Expect quiet hours", func(t *testing.T) {
+		assert.Equal(t, quietHours, got.Settings().QuietHours)
+	})
+}
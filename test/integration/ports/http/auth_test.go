@@ -0,0 +1,106 @@
+package http
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	httport "github.com/ARUMANDESU/go-revise/internal/ports/http"
+	"github.com/ARUMANDESU/go-revise/pkg/env"
+)
+
+func TestAuth_TelegramUser(t *testing.T) {
+	tests := []struct {
+		name         string
+		autoRegister bool
+		chatID       int64
+		languageCode string
+		status       int
+		wantLanguage string
+	}{
+		{
+			name:   "registered user",
+			chatID: chatID, status: http.StatusOK, wantLanguage: "en",
+		},
+		{
+			name:   "unregistered user is forbidden",
+			chatID: unregisteredChatID, languageCode: "ru", status: http.StatusForbidden,
+		},
+		{
+			name:         "unregistered user is registered in the language of the client",
+			autoRegister: true,
+			chatID:       unregisteredChatID, languageCode: "ru", status: http.StatusOK, wantLanguage: "ru",
+		},
+		{
+			name:         "unregistered user with unsupported language is registered in english",
+			autoRegister: true,
+			chatID:       unregisteredChatID, languageCode: "de", status: http.StatusOK, wantLanguage: "en",
+		},
+		{
+			name:         "registered user keeps the language with auto registration",
+			autoRegister: true,
+			chatID:       chatID, languageCode: "kk", status: http.StatusOK, wantLanguage: "en",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Config{EnvMode: env.Local, HTTP: config.HTTP{AutoRegister: tt.autoRegister}}
+			server := httptest.NewServer(httport.NewPort(cfg, NewApplication(t)).Handler())
+			t.Cleanup(server.Close)
+
+			authorization := tmaAuthorizationWithLanguage(tt.chatID, tt.languageCode)
+			status, body := getUser(t, server.URL, authorization)
+			require.Equal(t, tt.status, status, body)
+			if tt.status != http.StatusOK {
+				return
+			}
+			assert.Equal(t, float64(tt.chatID), body["user"].(map[string]any)["chat_id"])
+			assert.Equal(t, tt.wantLanguage, body["user"].(map[string]any)["settings"].(map[string]any)["language"])
+
+			// the next requests are served by the same user
+			status, again := getUser(t, server.URL, authorization)
+			require.Equal(t, http.StatusOK, status, again)
+			assert.Equal(t, body["user"], again["user"])
+		})
+	}
+}
+
+func TestAuth_TokenAndTelegramUserAreTheSame(t *testing.T) {
+	app := NewApplication(t)
+	server := httptest.NewServer(httport.NewPort(config.Config{EnvMode: env.Local}, app).Handler())
+	t.Cleanup(server.Close)
+
+	status, byTelegram := getUser(t, server.URL, tmaAuthorization(chatID))
+	require.Equal(t, http.StatusOK, status, byTelegram)
+	status, byToken := getUser(t, server.URL, "Bearer "+createAPIToken(t, app, apitoken.ScopeRead))
+	require.Equal(t, http.StatusOK, status, byToken)
+
+	assert.Equal(t, userID.String(), byTelegram["user"].(map[string]any)["id"])
+	assert.Equal(t, byTelegram["user"], byToken["user"])
+}
+
+// getUser requests the user with the authorization and returns the status and the decoded body.
+func getUser(t *testing.T, serverURL, authorization string) (int, map[string]any) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, serverURL+"/api/v1/users", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", authorization)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(raw, &body), string(raw))
+	return resp.StatusCode, body
+}
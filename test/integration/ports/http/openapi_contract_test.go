@@ -0,0 +1,681 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ARUMANDESU/go-revise/internal/application"
+	apitokenapp "github.com/ARUMANDESU/go-revise/internal/application/apitoken"
+	apitokencmd "github.com/ARUMANDESU/go-revise/internal/application/apitoken/command"
+	apitokenquery "github.com/ARUMANDESU/go-revise/internal/application/apitoken/query"
+	reviseitemapp "github.com/ARUMANDESU/go-revise/internal/application/reviseitem"
+	reviseitemcmd "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/command"
+	reviseitemquery "github.com/ARUMANDESU/go-revise/internal/application/reviseitem/query"
+	userapp "github.com/ARUMANDESU/go-revise/internal/application/user"
+	usercmd "github.com/ARUMANDESU/go-revise/internal/application/user/command"
+	userquery "github.com/ARUMANDESU/go-revise/internal/application/user/query"
+	"github.com/ARUMANDESU/go-revise/internal/config"
+	"github.com/ARUMANDESU/go-revise/internal/domain/apitoken"
+	"github.com/ARUMANDESU/go-revise/internal/domain/intervalprofile"
+	"github.com/ARUMANDESU/go-revise/internal/domain/reviseitem"
+	"github.com/ARUMANDESU/go-revise/internal/domain/scheduler"
+	"github.com/ARUMANDESU/go-revise/internal/domain/user/repository"
+	httport "github.com/ARUMANDESU/go-revise/internal/ports/http"
+	"github.com/ARUMANDESU/go-revise/internal/ports/http/openapi"
+	"github.com/ARUMANDESU/go-revise/pkg/env"
+	"github.com/ARUMANDESU/go-revise/test/integration/tester"
+)
+
+// userID is the user e471de92-5652-46b4-94e9-5ad1766874f7 from the mock data.
+var userID = uuid.FromStringOrNil("e471de92-5652-46b4-94e9-5ad1766874f7")
+
+const (
+	// chatID is the telegram id of the user e471de92-5652-46b4-94e9-5ad1766874f7 from the mock data.
+	chatID = 123456789
+	// unregisteredChatID is a telegram user who has not registered in the bot.
+	unregisteredChatID = 246813579
+	// mathItemID and physicsItemID are the items of the user, foreignItemID belongs to another user.
+	mathItemID    = "d7accc08-981f-4aa7-8477-b1840b9a2611"
+	physicsItemID = "e6ff2ac2-f4d1-4fcf-ae41-5509291dd799"
+	foreignItemID = "50fcccfc-067a-4757-b508-c08a4a33fb06"
+)
+
+// TestOpenAPI_Routes checks that every /api/v1 route of the router is documented and that
+// the document has no operations the router does not serve.
+func TestOpenAPI_Routes(t *testing.T) {
+	port := httport.NewPort(config.Config{EnvMode: env.Local}, NewApplication(t))
+	doc := loadDocument(t)
+
+	routes := make(map[string]bool)
+	err := chi.Walk(
+		port.Handler().(chi.Routes),
+		func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+			if strings.HasPrefix(route, "/api/v1/") {
+				routes[operationKey(method, strings.TrimSuffix(route, "/"))] = true
+			}
+			return nil
+		},
+	)
+	require.NoError(t, err)
+
+	documented := make(map[string]bool)
+	for path, item := range doc.object(t, "paths") {
+		for method := range item.(map[string]any) {
+			documented[operationKey(method, path)] = true
+		}
+	}
+
+	assert.Equal(t, sortedKeys(routes), sortedKeys(documented))
+}
+
+// TestOpenAPI_Contract sends requests to every documented operation and validates the request bodies
+// and the responses against the document.
+func TestOpenAPI_Contract(t *testing.T) {
+	app := NewApplication(t)
+	port := httport.NewPort(config.Config{EnvMode: env.Local}, app)
+	server := httptest.NewServer(port.Handler())
+	t.Cleanup(server.Close)
+	doc := loadDocument(t)
+
+	readToken := createAPIToken(t, app, apitoken.ScopeRead)
+	writeToken := createAPIToken(t, app, apitoken.ScopeWrite)
+
+	item := func(id string, rest ...string) string {
+		return "/api/v1/revise-items/" + id + strings.Join(rest, "")
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		route  string
+		noAuth bool
+		// authorization replaces the tma authorization of the user.
+		authorization string
+		body          any
+		// invalid marks the bodies breaking the document, the server must reject them as well.
+		invalid  bool
+		status   int
+		validate func(t *testing.T, body map[string]any)
+	}{
+		{
+			name: "OpenAPI document", method: http.MethodGet, path: "/api/v1/openapi.json",
+			noAuth: true, status: http.StatusOK,
+		},
+		{
+			name: "Register user", method: http.MethodPost, path: "/api/v1/users/register",
+			noAuth: true, body: map[string]any{"chat_id": 555000111}, status: http.StatusCreated,
+		},
+		{
+			name: "Register user twice", method: http.MethodPost, path: "/api/v1/users/register",
+			noAuth: true, body: map[string]any{"chat_id": 555000111}, status: http.StatusConflict,
+		},
+		{
+			name: "Register user with unknown field", method: http.MethodPost, path: "/api/v1/users/register",
+			noAuth: true, body: map[string]any{"chat_id": 555000112, "name": "Rob"}, invalid: true, status: http.StatusBadRequest,
+		},
+		{name: "Get user", method: http.MethodGet, path: "/api/v1/users", status: http.StatusOK},
+		{name: "Get settings", method: http.MethodGet, path: "/api/v1/users/settings", status: http.StatusOK},
+		{
+			name: "Put settings", method: http.MethodPut, path: "/api/v1/users/settings",
+			body: map[string]any{
+				"language":       "kk",
+				"reminder_slots": []string{"07:30", "21:00@workdays"},
+				"quiet_hours":    "23:00-07:00",
+				"timezone":       "Asia/Almaty",
+			},
+			status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				settings := body["settings"].(map[string]any)
+				assert.Equal(t, "Asia/Almaty", settings["timezone"])
+				assert.Len(t, settings["reminder_slots"], 2)
+			},
+		},
+		{
+			name: "Patch settings", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body: map[string]any{"daily_review_limit": 20}, status: http.StatusOK,
+		},
+		{
+			name: "Patch settings webhook", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body:   map[string]any{"webhook_url": "https://example.com/hooks/revise", "channels": []string{"telegram", "webhook"}},
+			status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.True(t, strings.HasPrefix(body["webhook_secret"].(string), "whsec_"))
+			},
+		},
+		{
+			name: "Patch settings webhook url", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body: map[string]any{"webhook_url": "https://example.com/hooks/other"}, status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.NotContains(t, body, "webhook_secret", "the secret is kept while the channel is on")
+			},
+		},
+		{
+			name: "Patch settings without webhook", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body: map[string]any{"webhook_url": "", "channels": []string{"telegram"}}, status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.NotContains(t, body, "webhook_secret")
+				assert.Empty(t, body["settings"].(map[string]any)["webhook_url"])
+			},
+		},
+		{
+			name: "Patch plain http webhook", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body: map[string]any{"webhook_url": "http://example.com/hooks/revise"}, status: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "Patch invalid settings", method: http.MethodPatch, path: "/api/v1/users/settings",
+			body: map[string]any{"timezone": "Mars/Olympus"}, status: http.StatusUnprocessableEntity,
+		},
+		{
+			name: "List revise items", method: http.MethodGet, path: "/api/v1/revise-items?page=1&page_size=1",
+			status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.Len(t, body["revise_items"], 1)
+				assert.EqualValues(t, 2, body["metadata"].(map[string]any)["total_records"])
+			},
+		},
+		{
+			name: "List revise items with invalid page", method: http.MethodGet, path: "/api/v1/revise-items?page=first",
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "Create revise item", method: http.MethodPost, path: "/api/v1/revise-items",
+			body: map[string]any{
+				"name":        "Go Memory Model",
+				"description": "happens before",
+				"tags":        []string{"go", "concurrency"},
+				"source":      "https://go.dev/ref/mem",
+			},
+			status: http.StatusCreated,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.Equal(t, []any{"go", "concurrency"}, body["revise_item"].(map[string]any)["tags"])
+			},
+		},
+		{name: "List due revise items", method: http.MethodGet, path: "/api/v1/revise-items/due", status: http.StatusOK},
+		{
+			name: "Get revise item", method: http.MethodGet, path: item(mathItemID), route: "/api/v1/revise-items/{id}",
+			status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.Len(t, body["revise_item"].(map[string]any)["revisions"], 2)
+			},
+		},
+		{
+			name: "Get revise item of another user", method: http.MethodGet, path: item(foreignItemID),
+			route: "/api/v1/revise-items/{id}", status: http.StatusNotFound,
+		},
+		{
+			name: "Get revise item with invalid id", method: http.MethodGet, path: item("physics"),
+			route: "/api/v1/revise-items/{id}", status: http.StatusBadRequest,
+		},
+		{
+			name: "Change revise item", method: http.MethodPatch, path: item(mathItemID),
+			route: "/api/v1/revise-items/{id}", body: map[string]any{"name": "Algebra", "description": "Linear equations"},
+			status: http.StatusOK,
+		},
+		{
+			name: "Change revise item with invalid description", method: http.MethodPatch, path: item(mathItemID),
+			route:  "/api/v1/revise-items/{id}",
+			body:   map[string]any{"name": "Geometry", "description": strings.Repeat("x", 1025)},
+			status: http.StatusBadRequest,
+		},
+		{
+			name: "Get revise item after invalid change", method: http.MethodGet, path: item(mathItemID),
+			route: "/api/v1/revise-items/{id}", status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				assert.Equal(t, "Algebra", body["revise_item"].(map[string]any)["name"], "the name must stay unchanged")
+			},
+		},
+		{
+			name: "Add revise item tags", method: http.MethodPost, path: item(mathItemID, "/tags"),
+			route: "/api/v1/revise-items/{id}/tags", body: map[string]any{"tags": []string{"algebra"}},
+			status: http.StatusOK,
+		},
+		{
+			name: "Remove revise item tag", method: http.MethodDelete, path: item(mathItemID, "/tags/algebra"),
+			route: "/api/v1/revise-items/{id}/tags/{tag}", status: http.StatusOK,
+		},
+		{
+			name: "Review revise item", method: http.MethodPost, path: item(mathItemID, "/review"),
+			route:  "/api/v1/revise-items/{id}/review",
+			body:   map[string]any{"grade": "good", "notes": "fine", "duration_seconds": 90, "confidence": 4},
+			status: http.StatusOK,
+		},
+		{
+			name: "Review revise item with invalid grade", method: http.MethodPost, path: item(mathItemID, "/review"),
+			route: "/api/v1/revise-items/{id}/review", body: map[string]any{"grade": "perfect"},
+			invalid: true, status: http.StatusBadRequest,
+		},
+		{
+			name: "Get reviewed revise item", method: http.MethodGet, path: item(mathItemID), route: "/api/v1/revise-items/{id}",
+			status: http.StatusOK,
+			validate: func(t *testing.T, body map[string]any) {
+				revisions := body["revise_item"].(map[string]any)["revisions"].([]any)
+				require.Len(t, revisions, 3)
+				assert.Contains(t, revisions, map[string]any{
+					"id":               revisions[2].(map[string]any)["id"],
+					"revised_at":       revisions[2].(map[string]any)["revised_at"],
+					"kind":             "review",
+					"grade":            "good",
+					"lapse":            false,
+					"notes":            "fine",
+					"duration_seconds": float64(90),
+					"confidence":       float64(4),
+				})
+			},
+		},
+		{
+			name: "Postpone revise item", method: http.MethodPost, path: item(mathItemID, "/postpone"),
+			route: "/api/v1/revise-items/{id}/postpone", body: map[string]any{"duration": "2d"},
+			status: http.StatusOK,
+		},
+		{
+			name: "Reset revise item progress", method: http.MethodPost, path: item(mathItemID, "/reset-progress"),
+			route: "/api/v1/revise-items/{id}/reset-progress", status: http.StatusOK,
+		},
+		{
+			name: "Delete revise item", method: http.MethodDelete, path: item(physicsItemID),
+			route: "/api/v1/revise-items/{id}", status: http.StatusOK,
+		},
+		{
+			name: "Restore revise item", method: http.MethodPost, path: item(physicsItemID, "/restore"),
+			route: "/api/v1/revise-items/{id}/restore", status: http.StatusOK,
+		},
+		{
+			name: "Delete revise item of another user", method: http.MethodDelete, path: item(foreignItemID),
+			route: "/api/v1/revise-items/{id}", status: http.StatusForbidden,
+		},
+		{
+			name: "Unsupported authorization", method: http.MethodGet, path: "/api/v1/users",
+			authorization: "Basic cm9iOnBpa2U=", status: http.StatusUnauthorized,
+		},
+		{
+			name: "Authorization without data", method: http.MethodGet, path: "/api/v1/users",
+			authorization: "tma", status: http.StatusUnauthorized,
+		},
+		{
+			name: "Authorization with extra parts", method: http.MethodGet, path: "/api/v1/users/settings",
+			authorization: "Bearer some token", status: http.StatusUnauthorized,
+		},
+		{
+			name: "Missing authorization", method: http.MethodGet, path: "/api/v1/users",
+			noAuth: true, status: http.StatusUnauthorized,
+		},
+		{
+			name: "Get user not registered in the bot", method: http.MethodGet, path: "/api/v1/users",
+			authorization: tmaAuthorization(unregisteredChatID), status: http.StatusForbidden,
+		},
+		{
+			name: "Get settings with api token", method: http.MethodGet, path: "/api/v1/users/settings",
+			authorization: "Bearer " + readToken, status: http.StatusOK,
+		},
+		{
+			name: "Add revise item tags with read api token", method: http.MethodPost, path: item(physicsItemID, "/tags"),
+			route: "/api/v1/revise-items/{id}/tags", body: map[string]any{"tags": []string{"mechanics"}},
+			authorization: "Bearer " + readToken, status: http.StatusForbidden,
+		},
+		{
+			name: "Add revise item tags with write api token", method: http.MethodPost, path: item(physicsItemID, "/tags"),
+			route: "/api/v1/revise-items/{id}/tags", body: map[string]any{"tags": []string{"mechanics"}},
+			authorization: "Bearer " + writeToken, status: http.StatusOK,
+		},
+		{
+			name: "Get revise items with unknown api token", method: http.MethodGet, path: "/api/v1/revise-items",
+			authorization: "Bearer " + apitoken.Prefix + "unknown", status: http.StatusUnauthorized,
+		},
+	}
+
+	exercised := make(map[string]bool)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			route := tt.route
+			if route == "" {
+				route, _, _ = strings.Cut(tt.path, "?")
+			}
+			operation := doc.operation(t, tt.method, route)
+			exercised[operationKey(tt.method, route)] = true
+
+			var reqBody io.Reader
+			if tt.body != nil {
+				raw, err := json.Marshal(tt.body)
+				require.NoError(t, err)
+				reqBody = bytes.NewReader(raw)
+
+				schema := doc.at(t, operation, "requestBody", "content", "application/json", "schema")
+				err = doc.validate(schema, decode(t, raw), "request")
+				if tt.invalid {
+					require.Error(t, err, "the request body must break the document")
+				} else {
+					require.NoError(t, err, "the request body must follow the document")
+				}
+			}
+
+			req, err := http.NewRequest(tt.method, server.URL+tt.path, reqBody)
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			switch {
+			case tt.authorization != "":
+				req.Header.Set("Authorization", tt.authorization)
+			case !tt.noAuth:
+				req.Header.Set("Authorization", tmaAuthorization(chatID))
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+			raw, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.status, resp.StatusCode, string(raw))
+
+			response, ok := doc.object(t, "paths", route, strings.ToLower(tt.method), "responses")[fmt.Sprint(tt.status)]
+			require.True(t, ok, "status %d is not documented", tt.status)
+			schema := doc.at(t, doc.resolve(response), "content", "application/json", "schema")
+			body := decode(t, raw)
+			require.NoError(t, doc.validate(schema, body, "response"), string(raw))
+
+			if tt.validate != nil {
+				tt.validate(t, body.(map[string]any))
+			}
+		})
+	}
+
+	for path, item := range doc.object(t, "paths") {
+		for method := range item.(map[string]any) {
+			assert.True(t, exercised[operationKey(method, path)], "%s %s is not exercised", method, path)
+		}
+	}
+}
+
+// NewApplication returns the application the http port serves over the mock data.
+func NewApplication(t *testing.T) application.Application {
+	t.Helper()
+
+	db := tester.NewSQLiteDB(t)
+	userRepo := repository.NewSQLiteRepo(db)
+	reviseitemRepo := reviseitem.NewSQLiteRepo(db)
+	intervalProfileRepo := intervalprofile.NewSQLiteRepo(db)
+	apiTokenRepo := apitoken.NewSQLiteRepo(db)
+	resolver := intervalprofile.NewResolver(&intervalProfileRepo, &userRepo)
+
+	return application.Application{
+		User: userapp.Application{
+			Commands: userapp.Commands{
+				RegisterUser:   usercmd.NewRegisterUserHandler(&userRepo),
+				ChangeSettings: usercmd.NewChangeSettingsHandler(&userRepo, &userRepo),
+			},
+			Queries: userapp.Queries{
+				GetUser: userquery.NewGetUserHandler(&userRepo),
+			},
+		},
+		ReviseItem: reviseitemapp.Application{
+			Query: reviseitemapp.Query{
+				GetReviseItem:         reviseitemquery.NewGetReviseItemHandler(&reviseitemRepo),
+				ListUserReviseItems:   reviseitemquery.NewListUserReviseItemsHandler(&reviseitemRepo),
+				ListDueReviseItems:    reviseitemquery.NewListDueReviseItemsHandler(&reviseitemRepo, &userRepo, &userRepo),
+				ListUserTags:          reviseitemquery.NewListUserTagsHandler(&reviseitemRepo),
+				SearchUserReviseItems: reviseitemquery.NewSearchUserReviseItemsHandler(&reviseitemRepo),
+			},
+			Command: reviseitemapp.Command{
+				NewReviseItem: reviseitemcmd.NewNewReviseItemHandler(
+					&reviseitemRepo,
+					resolver,
+					&intervalProfileRepo,
+					nil,
+				),
+				DeleteReviseItem:   reviseitemcmd.NewDeleteReviseItemHandler(&reviseitemRepo),
+				RestoreReviseItem:  reviseitemcmd.NewRestoreReviseItemHandler(&reviseitemRepo),
+				ChangeDescription:  reviseitemcmd.NewChangeDescriptionHandler(&reviseitemRepo),
+				ChangeName:         reviseitemcmd.NewChangeNameHandler(&reviseitemRepo),
+				AddTags:            reviseitemcmd.NewAddTagsHandler(&reviseitemRepo),
+				RemoveTags:         reviseitemcmd.NewRemoveTagsHandler(&reviseitemRepo),
+				Review:             reviseitemcmd.NewReviewHandler(&reviseitemRepo, resolver, &userRepo, scheduler.AlgorithmLadder, nil),
+				Postpone:           reviseitemcmd.NewPostponeHandler(&reviseitemRepo),
+				ResetProgress:      reviseitemcmd.NewResetProgressHandler(&reviseitemRepo, resolver),
+				SetIntervalProfile: reviseitemcmd.NewSetIntervalProfileHandler(&reviseitemRepo, &intervalProfileRepo),
+				ChangeReviseItem:   reviseitemcmd.NewChangeReviseItemHandler(&reviseitemRepo, &intervalProfileRepo),
+			},
+		},
+		APIToken: apitokenapp.Application{
+			Commands: apitokenapp.Commands{
+				CreateToken:  apitokencmd.NewCreateTokenHandler(&apiTokenRepo),
+				RevokeToken:  apitokencmd.NewRevokeTokenHandler(&apiTokenRepo),
+				Authenticate: apitokencmd.NewAuthenticateHandler(&apiTokenRepo),
+			},
+			Queries: apitokenapp.Queries{
+				ListUserTokens: apitokenquery.NewListUserTokensHandler(&apiTokenRepo),
+			},
+		},
+	}
+}
+
+// createAPIToken creates an api token of the user from the mock data.
+func createAPIToken(t *testing.T, app application.Application, scope apitoken.Scope) string {
+	t.Helper()
+	token, err := app.APIToken.Commands.CreateToken.Handle(context.Background(), apitokencmd.CreateToken{
+		ID:     apitoken.NewTokenID(),
+		UserID: userID,
+		Name:   "contract test " + scope.String(),
+		Scope:  scope,
+	})
+	require.NoError(t, err)
+	return token
+}
+
+// tmaAuthorization returns the authorization header of the telegram user,
+// the init data is not signed as the local mode does not validate it.
+func tmaAuthorization(telegramID int64) string {
+	return tmaAuthorizationWithLanguage(telegramID, "")
+}
+
+// tmaAuthorizationWithLanguage returns the authorization header of the telegram user
+// whose client is in the language.
+func tmaAuthorizationWithLanguage(telegramID int64, languageCode string) string {
+	user := map[string]any{"id": telegramID, "first_name": "Rob"}
+	if languageCode != "" {
+		user["language_code"] = languageCode
+	}
+	raw, _ := json.Marshal(user)
+	initData := url.Values{
+		"user":      {string(raw)},
+		"auth_date": {fmt.Sprint(time.Now().Unix())},
+	}
+	return "tma " + initData.Encode()
+}
+
+// document is the decoded OpenAPI document with a minimal JSON schema validator,
+// it knows the subset of the OpenAPI schemas the document uses.
+type document map[string]any
+
+func loadDocument(t *testing.T) document {
+	t.Helper()
+	var doc document
+	require.NoError(t, json.Unmarshal(openapi.Document, &doc))
+	require.Equal(t, "3.0.3", doc["openapi"])
+	return doc
+}
+
+// operation returns the operation of the method on the documented route.
+func (d document) operation(t *testing.T, method, route string) map[string]any {
+	t.Helper()
+	return d.object(t, "paths", route, strings.ToLower(method))
+}
+
+// object returns the object at the keys of the document.
+func (d document) object(t *testing.T, keys ...string) map[string]any {
+	t.Helper()
+	return d.at(t, map[string]any(d), keys...)
+}
+
+// at returns the object at the keys of the node, the references on the way are resolved.
+func (d document) at(t *testing.T, node map[string]any, keys ...string) map[string]any {
+	t.Helper()
+	for _, key := range keys {
+		next, ok := d.resolve(node)[key].(map[string]any)
+		require.True(t, ok, "%q is missing in the document", strings.Join(keys, "."))
+		node = next
+	}
+	return node
+}
+
+// resolve follows the $ref of the node.
+func (d document) resolve(node any) map[string]any {
+	obj, _ := node.(map[string]any)
+	for {
+		ref, ok := obj["$ref"].(string)
+		if !ok {
+			return obj
+		}
+		obj = map[string]any(d)
+		for _, key := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+			obj, _ = obj[key].(map[string]any)
+		}
+	}
+}
+
+// validate checks the value against the schema, objects don't allow undocumented properties
+// unless additionalProperties is set.
+func (d document) validate(schemaNode any, value any, path string) error {
+	schema := d.resolve(schemaNode)
+	if schema == nil {
+		return fmt.Errorf("%s: schema is missing", path)
+	}
+	if allOf, ok := schema["allOf"].([]any); ok {
+		schema = d.merge(allOf)
+	}
+
+	if value == nil {
+		if nullable, _ := schema["nullable"].(bool); nullable {
+			return nil
+		}
+		return fmt.Errorf("%s: is null", path)
+	}
+
+	if enum, ok := schema["enum"].([]any); ok && !containsValue(enum, value) {
+		return fmt.Errorf("%s: %v is not one of %v", path, value, enum)
+	}
+
+	switch schema["type"] {
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("%s: %v is not a string", path, value)
+		}
+		switch schema["format"] {
+		case "date-time":
+			if _, err := time.Parse(time.RFC3339, s); err != nil {
+				return fmt.Errorf("%s: %q is not a date-time", path, s)
+			}
+		case "uuid":
+			if _, err := uuid.FromString(s); err != nil {
+				return fmt.Errorf("%s: %q is not a uuid", path, s)
+			}
+		}
+	case "integer":
+		n, ok := value.(float64)
+		if !ok || n != float64(int64(n)) {
+			return fmt.Errorf("%s: %v is not an integer", path, value)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("%s: %v is not a boolean", path, value)
+		}
+	case "array":
+		items, ok := value.([]any)
+		if !ok {
+			return fmt.Errorf("%s: %v is not an array", path, value)
+		}
+		for i, item := range items {
+			if err := d.validate(schema["items"], item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+				return err
+			}
+		}
+	case "object":
+		obj, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("%s: %v is not an object", path, value)
+		}
+		properties, _ := schema["properties"].(map[string]any)
+		required, _ := schema["required"].([]any)
+		for _, name := range required {
+			if _, ok := obj[name.(string)]; !ok {
+				return fmt.Errorf("%s: %q is required", path, name)
+			}
+		}
+		additional, _ := schema["additionalProperties"].(bool)
+		for name, v := range obj {
+			property, ok := properties[name]
+			if !ok {
+				if additional {
+					continue
+				}
+				return fmt.Errorf("%s: %q is not documented", path, name)
+			}
+			if err := d.validate(property, v, path+"."+name); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("%s: unsupported schema type %v", path, schema["type"])
+	}
+	return nil
+}
+
+// merge combines the object schemas of allOf into one, so each of them allows the properties of the others.
+func (d document) merge(allOf []any) map[string]any {
+	properties := make(map[string]any)
+	var required []any
+	for _, node := range allOf {
+		schema := d.resolve(node)
+		for name, property := range schema["properties"].(map[string]any) {
+			properties[name] = property
+		}
+		if r, ok := schema["required"].([]any); ok {
+			required = append(required, r...)
+		}
+	}
+	return map[string]any{"type": "object", "properties": properties, "required": required}
+}
+
+func containsValue(values []any, value any) bool {
+	for _, v := range values {
+		if reflect.DeepEqual(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func decode(t *testing.T, raw []byte) any {
+	t.Helper()
+	var v any
+	require.NoError(t, json.Unmarshal(raw, &v), string(raw))
+	return v
+}
+
+func operationKey(method, route string) string {
+	return strings.ToUpper(method) + " " + route
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -2,4 +2,6 @@ DELETE FROM revisions;
 
 DELETE FROM revise_items;
 
+DELETE FROM user_reminder_slots;
+
 DELETE FROM users;
@@ -1,8 +1,14 @@
-INSERT INTO users (id, chat_id, language, reminder_time)
+INSERT INTO users (id, chat_id, language)
 VALUES 
-    ('e471de92-5652-46b4-94e9-5ad1766874f7', 123456789, 'en', '21:00'),
-    ('b0fca268-3772-407e-b446-b41ba44bf33d', 987654321, 'fr', '07:30'),
-    ('50fcccfc-067a-4757-b508-c08a4a33fb06', 135792468, 'es', '18:45');
+    ('e471de92-5652-46b4-94e9-5ad1766874f7', 123456789, 'en'),
+    ('b0fca268-3772-407e-b446-b41ba44bf33d', 987654321, 'fr'),
+    ('50fcccfc-067a-4757-b508-c08a4a33fb06', 135792468, 'es');
+
+INSERT INTO user_reminder_slots (user_id, reminder_time, weekdays)
+VALUES 
+    ('e471de92-5652-46b4-94e9-5ad1766874f7', '21:00', 127),
+    ('b0fca268-3772-407e-b446-b41ba44bf33d', '07:30', 62),
+    ('50fcccfc-067a-4757-b508-c08a4a33fb06', '18:45', 127);
 
 INSERT INTO revise_items (id, user_id, name, description, tags, last_revised_at, next_revision_at)
 VALUES 